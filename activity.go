@@ -0,0 +1,261 @@
+package todoist
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// Maximum number of activity events per request.
+	maxActivityLimit int = 100
+	// Date and time format of the activity log API.
+	activityTimeFormat string = "2006-01-02T15:04"
+)
+
+// Type of objects in the activity log.
+type ActivityObjectType string
+
+const (
+	ActivityObjectTask    ActivityObjectType = "item"
+	ActivityObjectComment ActivityObjectType = "note"
+	ActivityObjectProject ActivityObjectType = "project"
+)
+
+// Type of events in the activity log.
+type ActivityEventType string
+
+const (
+	ActivityEventAdded       ActivityEventType = "added"
+	ActivityEventUpdated     ActivityEventType = "updated"
+	ActivityEventCompleted   ActivityEventType = "completed"
+	ActivityEventUncompleted ActivityEventType = "uncompleted"
+	ActivityEventDeleted     ActivityEventType = "deleted"
+	ActivityEventArchived    ActivityEventType = "archived"
+	ActivityEventUnarchived  ActivityEventType = "unarchived"
+	ActivityEventShared      ActivityEventType = "shared"
+	ActivityEventLeft        ActivityEventType = "left"
+)
+
+type ActivityEvent struct {
+	// Event ID.
+	ID int `json:"id"`
+	// Type of the changed object.
+	ObjectType ActivityObjectType `json:"object_type"`
+	// ID of the changed object.
+	ObjectID int `json:"object_id"`
+	// Type of the event.
+	EventType ActivityEventType `json:"event_type"`
+	// Date and time when the event happened.
+	EventDate string `json:"event_date"`
+	// ID of the project of the changed object.
+	ParentProjectID *int `json:"parent_project_id"`
+	// ID of the task of the changed comment.
+	ParentTaskID *int `json:"parent_item_id"`
+	// ID of the user who made the change (absent if the user is the current user).
+	InitiatorID *int `json:"initiator_id"`
+	// Details of the event decoded by the object type.
+	// It is *TaskEventData, *CommentEventData, *ProjectEventData, or nil for unknown object types.
+	ExtraData interface{} `json:"-"`
+	// Details of the event as returned by the API.
+	RawExtraData json.RawMessage `json:"extra_data"`
+}
+
+// Details of an event for a task.
+type TaskEventData struct {
+	// Content of the task.
+	Content string `json:"content"`
+	// Content of the task before the update.
+	LastContent *string `json:"last_content"`
+	// Due date of the task.
+	DueDate *string `json:"due_date"`
+	// Due date of the task before the update.
+	LastDueDate *string `json:"last_due_date"`
+	// ID of the user responsible for the task.
+	ResponsibleUID *int `json:"responsible_uid"`
+	// ID of the user responsible for the task before the update.
+	LastResponsibleUID *int `json:"last_responsible_uid"`
+	// Number of comments of the task.
+	NoteCount *int `json:"note_count"`
+	// Client that made the change.
+	Client *string `json:"client"`
+}
+
+// Details of an event for a comment.
+type CommentEventData struct {
+	// Content of the comment.
+	Content string `json:"content"`
+	// Content of the task of the comment.
+	ParentItemContent *string `json:"parent_item_content"`
+	// Client that made the change.
+	Client *string `json:"client"`
+}
+
+// Details of an event for a project.
+type ProjectEventData struct {
+	// Name of the project.
+	Name string `json:"name"`
+	// Name of the project before the update.
+	LastName *string `json:"last_name"`
+	// Client that made the change.
+	Client *string `json:"client"`
+}
+
+// List of activity events.
+type ActivityEvents []*ActivityEvent
+
+// Options for getting the activity log.
+type ActivityOptions struct {
+	// Filter events by object type.
+	ObjectType *ActivityObjectType
+	// Filter events by object ID.
+	// Requires ObjectType.
+	ObjectID *int
+	// Filter events by event type.
+	EventType *ActivityEventType
+	// Filter events by the project of the changed objects.
+	ParentProjectID *int
+	// Filter events by the task of the changed comments.
+	ParentTaskID *int
+	// Filter events by the user who made the change.
+	InitiatorID *int
+	// Only events after the date and time.
+	Since *time.Time
+	// Only events before the date and time.
+	Until *time.Time
+	// Number of events fetched per request, up to 100.
+	// Default is 100.
+	PageSize *int
+}
+
+// Iterator over activity events, fetching pages as needed.
+//
+//	it := cl.GetActivity(opts)
+//	for it.Next() {
+//		fmt.Println(it.Event().EventType)
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type ActivityIterator struct {
+	cl      *Client
+	payload map[string]interface{}
+	limit   int
+	offset  int
+	events  ActivityEvents
+	current *ActivityEvent
+	done    bool
+	err     error
+}
+
+// Returns an iterator over the activity log, newest events first.
+func (cl *Client) GetActivity(opts ActivityOptions) *ActivityIterator {
+	p := map[string]interface{}{}
+	if opts.ObjectType != nil {
+		p["object_type"] = *opts.ObjectType
+	}
+	if opts.ObjectID != nil {
+		p["object_id"] = *opts.ObjectID
+	}
+	if opts.EventType != nil {
+		p["event_type"] = *opts.EventType
+	}
+	if opts.ParentProjectID != nil {
+		p["parent_project_id"] = *opts.ParentProjectID
+	}
+	if opts.ParentTaskID != nil {
+		p["parent_item_id"] = *opts.ParentTaskID
+	}
+	if opts.InitiatorID != nil {
+		p["initiator_id"] = *opts.InitiatorID
+	}
+	if opts.Since != nil {
+		p["since"] = opts.Since.UTC().Format(activityTimeFormat)
+	}
+	if opts.Until != nil {
+		p["until"] = opts.Until.UTC().Format(activityTimeFormat)
+	}
+
+	limit := maxActivityLimit
+	if opts.PageSize != nil && 0 < *opts.PageSize && *opts.PageSize < maxActivityLimit {
+		limit = *opts.PageSize
+	}
+
+	return &ActivityIterator{cl: cl, payload: p, limit: limit}
+}
+
+// Advances the iterator to the next event.
+// It returns false when there are no more events or an error occurred.
+func (it *ActivityIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if len(it.events) == 0 && !it.done {
+		it.fetch()
+	}
+	if len(it.events) == 0 {
+		it.current = nil
+		return false
+	}
+
+	it.current = it.events[0]
+	it.events = it.events[1:]
+	return true
+}
+
+// Returns the current event.
+func (it *ActivityIterator) Event() *ActivityEvent {
+	return it.current
+}
+
+// Returns the error that stopped the iteration, if any.
+func (it *ActivityIterator) Err() error {
+	return it.err
+}
+
+func (it *ActivityIterator) fetch() {
+	p := map[string]interface{}{"limit": it.limit, "offset": it.offset}
+	for k, v := range it.payload {
+		p[k] = v
+	}
+
+	out := struct {
+		Events ActivityEvents `json:"events"`
+		Count  int            `json:"count"`
+	}{}
+	if err := it.cl.syncPost("/v8/activity/get", p, &out); err != nil {
+		it.err = err
+		return
+	}
+
+	for _, ev := range out.Events {
+		if err := ev.decodeExtraData(); err != nil {
+			it.err = err
+			return
+		}
+	}
+
+	it.events = out.Events
+	it.offset += len(out.Events)
+	if len(out.Events) < it.limit || it.offset >= out.Count {
+		it.done = true
+	}
+}
+
+func (ev *ActivityEvent) decodeExtraData() error {
+	if len(ev.RawExtraData) == 0 || string(ev.RawExtraData) == "null" {
+		return nil
+	}
+
+	switch ev.ObjectType {
+	case ActivityObjectTask:
+		ev.ExtraData = &TaskEventData{}
+	case ActivityObjectComment:
+		ev.ExtraData = &CommentEventData{}
+	case ActivityObjectProject:
+		ev.ExtraData = &ProjectEventData{}
+	default:
+		return nil
+	}
+
+	return json.Unmarshal(ev.RawExtraData, ev.ExtraData)
+}
@@ -0,0 +1,84 @@
+package todoist
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newActivityRequestForTest(payload map[string]interface{}) *restRequest {
+	return &restRequest{
+		URL:     "https://api.todoist.com/sync/v8/activity/get",
+		Method:  http.MethodPost,
+		Payload: payload,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+	}
+}
+
+func TestClient_GetActivity(t *testing.T) {
+	t.Run("should iterate over pages of events", func(t *testing.T) {
+		cl, api := newClientForTest()
+		since := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+		filters := map[string]interface{}{"object_type": ActivityObjectTask, "parent_project_id": 10, "initiator_id": 100, "since": "2026-10-01T09:30"}
+		page := func(offset int) map[string]interface{} {
+			p := map[string]interface{}{"limit": 2, "offset": offset}
+			for k, v := range filters {
+				p[k] = v
+			}
+			return p
+		}
+		api.On("Do", newActivityRequestForTest(page(0))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body: strings.NewReader(`{ "count": 3, "events": [
+				{ "id": 1, "object_type": "item", "object_id": 1, "event_type": "updated", "event_date": "2026-10-02T10:00:00Z", "parent_project_id": 10, "initiator_id": 100, "extra_data": { "content": "NEW", "last_content": "OLD", "client": "web" } },
+				{ "id": 2, "object_type": "note", "object_id": 2, "event_type": "added", "event_date": "2026-10-02T09:00:00Z", "parent_project_id": 10, "parent_item_id": 1, "initiator_id": 100, "extra_data": { "content": "COMMENT", "parent_item_content": "NEW" } }
+			] }`),
+		}, nil)
+		api.On("Do", newActivityRequestForTest(page(2))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body: strings.NewReader(`{ "count": 3, "events": [
+				{ "id": 3, "object_type": "project", "object_id": 10, "event_type": "updated", "event_date": "2026-10-01T10:00:00Z", "parent_project_id": 10, "initiator_id": 100, "extra_data": { "name": "NEW", "last_name": "OLD" } }
+			] }`),
+		}, nil)
+
+		objType := ActivityObjectTask
+		it := cl.GetActivity(ActivityOptions{ObjectType: &objType, ParentProjectID: Int(10), InitiatorID: Int(100), Since: &since, PageSize: Int(2)})
+		evs := ActivityEvents{}
+		for it.Next() {
+			evs = append(evs, it.Event())
+		}
+
+		assert.NoError(t, it.Err())
+		assert.Len(t, evs, 3)
+		assert.Equal(t, &ActivityEvent{
+			ID: 1, ObjectType: ActivityObjectTask, ObjectID: 1, EventType: ActivityEventUpdated, EventDate: "2026-10-02T10:00:00Z",
+			ParentProjectID: Int(10), InitiatorID: Int(100),
+			ExtraData:    &TaskEventData{Content: "NEW", LastContent: String("OLD"), Client: String("web")},
+			RawExtraData: json.RawMessage(`{ "content": "NEW", "last_content": "OLD", "client": "web" }`),
+		}, evs[0])
+		assert.Equal(t, &CommentEventData{Content: "COMMENT", ParentItemContent: String("NEW")}, evs[1].ExtraData)
+		assert.Equal(t, Int(1), evs[1].ParentTaskID)
+		assert.Equal(t, &ProjectEventData{Name: "NEW", LastName: String("OLD")}, evs[2].ExtraData)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should stop with an error if the request fails", func(t *testing.T) {
+		cl, api := newClientForTest()
+		api.On("Do", newActivityRequestForTest(map[string]interface{}{"limit": 100, "offset": 0})).Return(&restResponse{
+			StatusCode: http.StatusBadRequest,
+			Body:       strings.NewReader("ERROR_RESPONSE"),
+		}, nil)
+
+		it := cl.GetActivity(ActivityOptions{})
+
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Event())
+		assert.Error(t, it.Err())
+		assert.IsType(t, RequestError{}, it.Err())
+		api.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,133 @@
+package todoist
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	// Returned when no collaborator of the project matches the email or name.
+	ErrNotCollaborator = errors.New("not a collaborator of the project")
+	// Returned when more than one collaborator of the project matches the name.
+	ErrAmbiguousAssignee = errors.New("multiple collaborators match")
+)
+
+// Resolver of assignees by email or name.
+// Collaborators are fetched once per project and cached.
+type AssigneeResolver struct {
+	cl *Client
+
+	mu    sync.Mutex
+	users map[int]Users
+}
+
+// Returns new assignee resolver with an empty cache.
+func NewAssigneeResolver(cl *Client) *AssigneeResolver {
+	return &AssigneeResolver{cl: cl, users: map[int]Users{}}
+}
+
+// Returns the collaborator of a project matching an email, or a name if no email matches.
+// Both are compared case-insensitively.
+func (r *AssigneeResolver) Resolve(projectID int, emailOrName string) (*User, error) {
+	return r.resolve(r.cl, projectID, emailOrName)
+}
+
+// Resolves an assignee, fetching collaborators with cl.
+func (r *AssigneeResolver) resolve(cl *Client, projectID int, emailOrName string) (*User, error) {
+	users, err := r.collaborators(cl, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	s := strings.TrimSpace(emailOrName)
+	for _, user := range users {
+		if strings.EqualFold(user.Email, s) {
+			return user, nil
+		}
+	}
+
+	var found *User
+	for _, user := range users {
+		if !strings.EqualFold(user.Name, s) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("%w: %q", ErrAmbiguousAssignee, emailOrName)
+		}
+		found = user
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: %q", ErrNotCollaborator, emailOrName)
+	}
+
+	return found, nil
+}
+
+// Removes the cached collaborators of a project.
+func (r *AssigneeResolver) Invalidate(projectID int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.users, projectID)
+}
+
+// Assigns a task to the collaborator of its project matching an email or name and returns the user.
+func (r *AssigneeResolver) AssignTask(taskID int, emailOrName string) (*User, error) {
+	return r.assignTask(r.cl, taskID, emailOrName)
+}
+
+// Assigns a task, sending requests with cl.
+func (r *AssigneeResolver) assignTask(cl *Client, taskID int, emailOrName string) (*User, error) {
+	task, err := cl.GetTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := r.resolve(cl, task.ProjectID, emailOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cl.UpdateTaskWithOptions(taskID, &UpdateTaskOptions{Assignee: &user.ID}); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *AssigneeResolver) collaborators(cl *Client, projectID int) (Users, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if users, ok := r.users[projectID]; ok {
+		return users, nil
+	}
+	users, err := cl.GetCollaborators(projectID)
+	if err != nil {
+		return nil, err
+	}
+	r.users[projectID] = users
+
+	return users, nil
+}
+
+// Assigns a task to the collaborator of its project matching an email or name and returns the user.
+// Collaborators are cached per project for the lifetime of the client and shared with its copies made by WithContext.
+func (cl *Client) AssignTask(taskID int, emailOrName string) (*User, error) {
+	return cl.assigneeResolver().assignTask(cl, taskID, emailOrName)
+}
+
+// Removes the assignee of a task.
+func (cl *Client) UnassignTask(taskID int) error {
+	return cl.UpdateTaskWithOptions(taskID, &UpdateTaskOptions{Assignee: Int(0)})
+}
+
+func (cl *Client) assigneeResolver() *AssigneeResolver {
+	cl.assigneesOnce.Do(func() {
+		// copies made by WithContext share the resolver of the original client.
+		if cl.assignees == nil {
+			cl.assignees = NewAssigneeResolver(cl)
+		}
+	})
+	return cl.assignees
+}
@@ -0,0 +1,187 @@
+package todoist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCollaboratorsResponseForTest() *restResponse {
+	return &restResponse{
+		StatusCode: http.StatusOK,
+		Body: strings.NewReader(`[
+			{ "id": 100, "name": "Alice", "email": "alice@example.com" },
+			{ "id": 200, "name": "Bob", "email": "bob@example.com" },
+			{ "id": 300, "name": "Bob", "email": "bob2@example.com" }
+		]`),
+	}
+}
+
+func newCollaboratorsRequestForTest(projectID int) *restRequest {
+	return &restRequest{
+		URL:     fmt.Sprintf("https://api.todoist.com/rest/v1/projects/%d/collaborators", projectID),
+		Method:  http.MethodGet,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+	}
+}
+
+func newAssignRequestForTest(taskID, assignee int) *restRequest {
+	return &restRequest{
+		URL:     fmt.Sprintf("https://api.todoist.com/rest/v1/tasks/%d", taskID),
+		Method:  http.MethodPost,
+		Payload: map[string]interface{}{"assignee": Int(assignee)},
+		Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+	}
+}
+
+func TestAssigneeResolver_Resolve(t *testing.T) {
+	tests := []struct {
+		name        string
+		emailOrName string
+		want        *User
+		wantErr     error
+	}{
+		{
+			name:        "should resolve by email",
+			emailOrName: "BOB2@example.com",
+			want:        &User{ID: 300, Name: "Bob", Email: "bob2@example.com"},
+		},
+		{
+			name:        "should resolve by name",
+			emailOrName: "alice",
+			want:        &User{ID: 100, Name: "Alice", Email: "alice@example.com"},
+		},
+		{
+			name:        "should return an error if the name is ambiguous",
+			emailOrName: "Bob",
+			wantErr:     ErrAmbiguousAssignee,
+		},
+		{
+			name:        "should return an error if the user is not a collaborator",
+			emailOrName: "carol@example.com",
+			wantErr:     ErrNotCollaborator,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", newCollaboratorsRequestForTest(1)).Return(newCollaboratorsResponseForTest(), nil).Once()
+			r := NewAssigneeResolver(cl)
+
+			user, err := r.Resolve(1, tt.emailOrName)
+
+			assert.Equal(t, tt.want, user)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should cache collaborators per project", func(t *testing.T) {
+		cl, api := newClientForTest()
+		api.On("Do", newCollaboratorsRequestForTest(1)).Return(newCollaboratorsResponseForTest(), nil).Once()
+		r := NewAssigneeResolver(cl)
+
+		_, err := r.Resolve(1, "alice")
+		assert.NoError(t, err)
+		_, err = r.Resolve(1, "bob@example.com")
+		assert.NoError(t, err)
+		api.AssertNumberOfCalls(t, "Do", 1)
+
+		r.Invalidate(1)
+		api.On("Do", newCollaboratorsRequestForTest(1)).Return(newCollaboratorsResponseForTest(), nil).Once()
+		_, err = r.Resolve(1, "alice")
+		assert.NoError(t, err)
+		api.AssertNumberOfCalls(t, "Do", 2)
+	})
+}
+
+func TestClient_AssignTask(t *testing.T) {
+	tests := []struct {
+		name        string
+		emailOrName string
+		want        *User
+		wantErr     bool
+	}{
+		{
+			name:        "should assign a task",
+			emailOrName: "alice@example.com",
+			want:        &User{ID: 100, Name: "Alice", Email: "alice@example.com"},
+			wantErr:     false,
+		},
+		{
+			name:        "should return an error if the user is not a collaborator",
+			emailOrName: "carol@example.com",
+			want:        nil,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", &restRequest{
+				URL:     "https://api.todoist.com/rest/v1/tasks/10",
+				Method:  http.MethodGet,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": 10, "project_id": 1, "content": "TASK" }`)}, nil)
+			api.On("Do", newCollaboratorsRequestForTest(1)).Return(newCollaboratorsResponseForTest(), nil)
+			if !tt.wantErr {
+				api.On("Do", newAssignRequestForTest(10, 100)).Return(&restResponse{StatusCode: http.StatusNoContent, Body: strings.NewReader("")}, nil)
+			}
+
+			user, err := cl.AssignTask(10, tt.emailOrName)
+
+			assert.Equal(t, tt.want, user)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrNotCollaborator)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_WithContext_AssignTask(t *testing.T) {
+	t.Run("should share collaborators and send requests with the context", func(t *testing.T) {
+		type ctxKey struct{}
+		cl, api := newClientForTest()
+		for i, ctx := range []context.Context{
+			context.WithValue(context.Background(), ctxKey{}, 1),
+			context.WithValue(context.Background(), ctxKey{}, 2),
+		} {
+			api.On("Do", withContextForTest(ctx, &restRequest{
+				URL:     "https://api.todoist.com/rest/v1/tasks/10",
+				Method:  http.MethodGet,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			})).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": 10, "project_id": 1, "content": "TASK" }`)}, nil).Once()
+			if i == 0 {
+				api.On("Do", withContextForTest(ctx, newCollaboratorsRequestForTest(1))).Return(newCollaboratorsResponseForTest(), nil).Once()
+			}
+			api.On("Do", withContextForTest(ctx, newAssignRequestForTest(10, 100))).Return(&restResponse{StatusCode: http.StatusNoContent, Body: strings.NewReader("")}, nil).Once()
+
+			user, err := cl.WithContext(ctx).AssignTask(10, "alice")
+
+			assert.NoError(t, err)
+			assert.Equal(t, 100, user.ID)
+		}
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_UnassignTask(t *testing.T) {
+	cl, api := newClientForTest()
+	api.On("Do", newAssignRequestForTest(10, 0)).Return(&restResponse{StatusCode: http.StatusNoContent, Body: strings.NewReader("")}, nil)
+
+	err := cl.UnassignTask(10)
+
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
@@ -0,0 +1,462 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Version of the backup archive format.
+const BackupVersion int = 1
+
+// Portable JSON archive of an account.
+type BackupArchive struct {
+	// Version of the archive format.
+	Version int `json:"version"`
+	// Date and time when the archive was created.
+	CreatedAt time.Time `json:"created_at"`
+	// All projects.
+	Projects Projects `json:"projects"`
+	// All sections.
+	Sections Sections `json:"sections"`
+	// All active tasks.
+	Tasks Tasks `json:"tasks"`
+	// All labels.
+	Labels Labels `json:"labels"`
+	// All project and task comments, including attachment metadata.
+	Comments Comments `json:"comments"`
+	// Collaborators of shared projects by project ID.
+	Collaborators map[int]Users `json:"collaborators"`
+}
+
+// Writes a snapshot of all projects, sections, active tasks, labels, comments and collaborators as a JSON archive.
+func (cl *Client) Backup(ctx context.Context, w io.Writer) error {
+	arc, err := cl.backup(ctx)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(arc)
+}
+
+func (cl *Client) backup(ctx context.Context) (*BackupArchive, error) {
+	c := cl.WithContext(ctx)
+	arc := &BackupArchive{Version: BackupVersion, CreatedAt: time.Now().UTC(), Comments: Comments{}, Collaborators: map[int]Users{}}
+
+	var err error
+	if arc.Projects, err = c.GetProjects(); err != nil {
+		return nil, err
+	}
+	if arc.Sections, err = c.GetSections(); err != nil {
+		return nil, err
+	}
+	if arc.Tasks, err = c.GetTasks(); err != nil {
+		return nil, err
+	}
+	if arc.Labels, err = c.GetLabels(); err != nil {
+		return nil, err
+	}
+
+	for _, proj := range arc.Projects {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if proj.CommentCount > 0 {
+			cmts, err := c.GetProjectComments(proj.ID)
+			if err != nil {
+				return nil, err
+			}
+			arc.Comments = append(arc.Comments, cmts...)
+		}
+		if proj.Shared {
+			users, err := c.GetCollaborators(proj.ID)
+			if err != nil {
+				return nil, err
+			}
+			arc.Collaborators[proj.ID] = users
+		}
+	}
+
+	taskIDs := []int{}
+	for _, task := range arc.Tasks {
+		if task.CommentCount > 0 {
+			taskIDs = append(taskIDs, task.ID)
+		}
+	}
+	taskCmts, err := c.GetTaskCommentsBulk(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range taskIDs {
+		arc.Comments = append(arc.Comments, taskCmts[id]...)
+	}
+
+	return arc, nil
+}
+
+// Policy for objects of the archive that already exist in the account.
+// Labels are matched by name, projects by name and parent, sections by name and project,
+// tasks by content, project and parent task, and comments by content on the same task or project.
+type ConflictPolicy int
+
+const (
+	// Reuses the existing object.
+	ConflictReuse ConflictPolicy = iota
+	// Creates a duplicate object.
+	ConflictDuplicate
+	// Fails the restore with ErrRestoreConflict.
+	ConflictFail
+)
+
+// Returned when an object of the archive already exists and the conflict policy is ConflictFail.
+var ErrRestoreConflict = errors.New("object already exists")
+
+// Options for restoring an archive.
+type RestoreOptions struct {
+	// Policy for objects that already exist.
+	// Default is ConflictReuse.
+	Conflict ConflictPolicy
+	// Function called after each object is restored.
+	Progress func(p RestoreProgress)
+}
+
+// Progress of restoring an archive.
+type RestoreProgress struct {
+	// Type of objects being restored ("labels", "projects", "sections", "tasks" or "comments").
+	Stage string
+	// Number of restored objects of the stage.
+	Done int
+	// Total number of objects of the stage.
+	Total int
+}
+
+// Result of restoring an archive.
+// Each mapping is from the archive IDs to the IDs in the account.
+type RestoreResult struct {
+	Labels   map[int]int
+	Projects map[int]int
+	Sections map[int]int
+	Tasks    map[int]int
+	Comments map[int]int
+}
+
+// Recreates the objects of a JSON archive in the account.
+// Collaborators, assignees and completed tasks are not restored.
+func (cl *Client) Restore(ctx context.Context, r io.Reader, opts RestoreOptions) (*RestoreResult, error) {
+	arc := BackupArchive{}
+	if err := json.NewDecoder(r).Decode(&arc); err != nil {
+		return nil, err
+	}
+	if arc.Version != BackupVersion {
+		return nil, fmt.Errorf("unsupported backup version: %d", arc.Version)
+	}
+
+	res := &RestoreResult{Labels: map[int]int{}, Projects: map[int]int{}, Sections: map[int]int{}, Tasks: map[int]int{}, Comments: map[int]int{}}
+	progress := func(stage string, done, total int) {
+		if opts.Progress != nil {
+			opts.Progress(RestoreProgress{Stage: stage, Done: done, Total: total})
+		}
+	}
+	conflict := func(kind, name string) error {
+		return fmt.Errorf("%w: %s %q", ErrRestoreConflict, kind, name)
+	}
+
+	c := cl.WithContext(ctx)
+
+	// labels
+	labels, err := c.GetLabels()
+	if err != nil {
+		return res, err
+	}
+	labelsByName := map[string]int{}
+	for _, l := range labels {
+		labelsByName[l.Name] = l.ID
+	}
+	for i, l := range arc.Labels {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		if id, ok := labelsByName[l.Name]; ok && opts.Conflict != ConflictDuplicate {
+			if opts.Conflict == ConflictFail {
+				return res, conflict("label", l.Name)
+			}
+			res.Labels[l.ID] = id
+		} else {
+			created, err := c.CreateLabelWithOptions(l.Name, &CreateLabelOptions{Order: &l.Order, Color: &l.Color, Favorite: &l.Favorite})
+			if err != nil {
+				return res, err
+			}
+			res.Labels[l.ID] = created.ID
+		}
+		progress("labels", i+1, len(arc.Labels))
+	}
+
+	// projects
+	projs, err := c.GetProjects()
+	if err != nil {
+		return res, err
+	}
+	type projectKey struct {
+		name     string
+		parentID int
+	}
+	projsByKey := map[projectKey]int{}
+	inboxID := 0
+	for _, p := range projs {
+		if p.InboxProject {
+			inboxID = p.ID
+		}
+		k := projectKey{name: p.Name}
+		if p.ParentID != nil {
+			k.parentID = *p.ParentID
+		}
+		projsByKey[k] = p.ID
+	}
+	for i, p := range sortProjectTree(arc.Projects) {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		var parentID *int
+		k := projectKey{name: p.Name}
+		if p.ParentID != nil {
+			if id, ok := res.Projects[*p.ParentID]; ok {
+				parentID = &id
+				k.parentID = id
+			}
+		}
+
+		if p.InboxProject && inboxID != 0 {
+			res.Projects[p.ID] = inboxID
+		} else if id, ok := projsByKey[k]; ok && opts.Conflict != ConflictDuplicate {
+			if opts.Conflict == ConflictFail {
+				return res, conflict("project", p.Name)
+			}
+			res.Projects[p.ID] = id
+		} else {
+			created, err := c.CreateProjectWithOptions(p.Name, &CreateProjectOptions{ParentID: parentID, Color: &p.Color, Favorite: &p.Favorite})
+			if err != nil {
+				return res, err
+			}
+			res.Projects[p.ID] = created.ID
+		}
+		progress("projects", i+1, len(arc.Projects))
+	}
+
+	// sections
+	secs, err := c.GetSections()
+	if err != nil {
+		return res, err
+	}
+	type sectionKey struct {
+		name      string
+		projectID int
+	}
+	secsByKey := map[sectionKey]int{}
+	for _, s := range secs {
+		secsByKey[sectionKey{name: s.Name, projectID: s.ProjectID}] = s.ID
+	}
+	for i, s := range arc.Sections {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		projectID := res.Projects[s.ProjectID]
+		if id, ok := secsByKey[sectionKey{name: s.Name, projectID: projectID}]; ok && opts.Conflict != ConflictDuplicate {
+			if opts.Conflict == ConflictFail {
+				return res, conflict("section", s.Name)
+			}
+			res.Sections[s.ID] = id
+		} else {
+			created, err := c.CreateSectionWithOptions(s.Name, projectID, &CreateSectionOptions{Order: &s.Order})
+			if err != nil {
+				return res, err
+			}
+			res.Sections[s.ID] = created.ID
+		}
+		progress("sections", i+1, len(arc.Sections))
+	}
+
+	// tasks
+	tasks, err := c.GetTasks()
+	if err != nil {
+		return res, err
+	}
+	type taskKey struct {
+		content   string
+		projectID int
+		parentID  int
+	}
+	tasksByKey := map[taskKey]int{}
+	existingTasks := map[int]bool{}
+	for _, t := range tasks {
+		k := taskKey{content: t.Content, projectID: t.ProjectID}
+		if t.ParentID != nil {
+			k.parentID = *t.ParentID
+		}
+		tasksByKey[k] = t.ID
+		existingTasks[t.ID] = true
+	}
+	for i, task := range sortTaskTree(arc.Tasks) {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		topts := &CreateTaskOptions{Order: &task.Order, Priority: &task.Priority}
+		k := taskKey{content: task.Content}
+		if id, ok := res.Projects[task.ProjectID]; ok {
+			topts.ProjectID = &id
+			k.projectID = id
+		}
+		if id, ok := res.Sections[task.SectionID]; ok {
+			topts.SectionID = &id
+		}
+		if task.ParentID != nil {
+			if id, ok := res.Tasks[*task.ParentID]; ok {
+				topts.ParentID = &id
+				k.parentID = id
+			}
+		}
+
+		if id, ok := tasksByKey[k]; ok && k.projectID != 0 && opts.Conflict != ConflictDuplicate {
+			if opts.Conflict == ConflictFail {
+				return res, conflict("task", task.Content)
+			}
+			res.Tasks[task.ID] = id
+			progress("tasks", i+1, len(arc.Tasks))
+			continue
+		}
+
+		if task.Description != "" {
+			topts.Description = &task.Description
+		}
+		if len(task.LabelIDs) > 0 {
+			labelIDs := []int{}
+			for _, id := range task.LabelIDs {
+				if newID, ok := res.Labels[id]; ok {
+					labelIDs = append(labelIDs, newID)
+				}
+			}
+			topts.LabelIDs = &labelIDs
+		}
+		if task.Due != nil && task.Due.String != "" {
+			topts.DueString = &task.Due.String
+		}
+
+		created, err := c.CreateTaskWithOptions(task.Content, topts)
+		if err != nil {
+			return res, err
+		}
+		res.Tasks[task.ID] = created.ID
+		progress("tasks", i+1, len(arc.Tasks))
+	}
+
+	// comments
+	existingProjects := map[int]bool{}
+	for _, p := range projs {
+		existingProjects[p.ID] = true
+	}
+	// comment IDs by content per task or project that existed before the restore, fetched on first use.
+	taskCmts := map[int]map[string]int{}
+	projectCmts := map[int]map[string]int{}
+	existingComment := func(cache map[int]map[string]int, id int, content string, get func(int) (Comments, error)) (int, bool, error) {
+		byContent, ok := cache[id]
+		if !ok {
+			cmts, err := get(id)
+			if err != nil {
+				return 0, false, err
+			}
+			byContent = map[string]int{}
+			for _, existing := range cmts {
+				byContent[existing.Content] = existing.ID
+			}
+			cache[id] = byContent
+		}
+		cmtID, ok := byContent[content]
+		return cmtID, ok, nil
+	}
+	for i, cmt := range arc.Comments {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		var taskID, projectID int
+		switch {
+		case cmt.TaskID != nil:
+			taskID = res.Tasks[*cmt.TaskID]
+		case cmt.ProjectID != nil:
+			projectID = res.Projects[*cmt.ProjectID]
+		}
+
+		if taskID != 0 || projectID != 0 {
+			var id int
+			var found bool
+			var err error
+			if opts.Conflict != ConflictDuplicate {
+				if taskID != 0 && existingTasks[taskID] {
+					id, found, err = existingComment(taskCmts, taskID, cmt.Content, c.GetTaskComments)
+				} else if projectID != 0 && existingProjects[projectID] {
+					id, found, err = existingComment(projectCmts, projectID, cmt.Content, c.GetProjectComments)
+				}
+				if err != nil {
+					return res, err
+				}
+			}
+
+			if found {
+				if opts.Conflict == ConflictFail {
+					return res, conflict("comment", cmt.Content)
+				}
+				res.Comments[cmt.ID] = id
+			} else {
+				var created *Comment
+				if taskID != 0 {
+					created, err = c.CreateTaskCommentWithOptions(taskID, cmt.Content, &CreateTaskCommentOptions{Attachment: cloneAttachment(cmt.Attachment)})
+				} else {
+					created, err = c.CreateProjectCommentWithOptions(projectID, cmt.Content, &CreateProjectCommentOptions{Attachment: cloneAttachment(cmt.Attachment)})
+				}
+				if err != nil {
+					return res, err
+				}
+				res.Comments[cmt.ID] = created.ID
+			}
+		}
+		progress("comments", i+1, len(arc.Comments))
+	}
+
+	return res, nil
+}
+
+// Returns projects ordered so that parents come before their children.
+func sortProjectTree(projs Projects) Projects {
+	ids := map[int]bool{}
+	for _, p := range projs {
+		ids[p.ID] = true
+	}
+
+	children := map[int]Projects{}
+	roots := Projects{}
+	for _, p := range projs {
+		if p.ParentID != nil && ids[*p.ParentID] {
+			children[*p.ParentID] = append(children[*p.ParentID], p)
+			continue
+		}
+		roots = append(roots, p)
+	}
+
+	sorted := Projects{}
+	var walk func(ps Projects)
+	walk = func(ps Projects) {
+		sort.SliceStable(ps, func(i, j int) bool { return ps[i].Order < ps[j].Order })
+		for _, p := range ps {
+			sorted = append(sorted, p)
+			walk(children[p.ID])
+		}
+	}
+	walk(roots)
+
+	return sorted
+}
@@ -0,0 +1,180 @@
+package todoist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Backup(t *testing.T) {
+	t.Run("should write an archive", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+		get := func(url, body string) {
+			api.On("Do", &restRequest{
+				Context: ctx,
+				URL:     url,
+				Method:  http.MethodGet,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil)
+		}
+		get("https://api.todoist.com/rest/v1/projects", `[{ "id": 1, "name": "PROJECT", "shared": true, "comment_count": 1 }]`)
+		get("https://api.todoist.com/rest/v1/sections", `[{ "id": 10, "project_id": 1, "name": "SECTION" }]`)
+		get("https://api.todoist.com/rest/v1/tasks", `[{ "id": 100, "project_id": 1, "content": "TASK", "comment_count": 1 }]`)
+		get("https://api.todoist.com/rest/v1/labels", `[{ "id": 1000, "name": "LABEL" }]`)
+		get("https://api.todoist.com/rest/v1/comments?project_id=1", `[{ "id": 2, "project_id": 1, "content": "PROJECT COMMENT" }]`)
+		get("https://api.todoist.com/rest/v1/projects/1/collaborators", `[{ "id": 3, "name": "USER", "email": "user@example.com" }]`)
+		get("https://api.todoist.com/rest/v1/comments?task_id=100", `[{ "id": 4, "task_id": 100, "content": "TASK COMMENT", "attachment": { "resource_type": "file", "file_name": "FILE" } }]`)
+
+		buf := new(bytes.Buffer)
+		err := cl.Backup(ctx, buf)
+		assert.NoError(t, err)
+
+		arc := BackupArchive{}
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &arc))
+		assert.Equal(t, BackupVersion, arc.Version)
+		assert.Len(t, arc.Projects, 1)
+		assert.Len(t, arc.Sections, 1)
+		assert.Len(t, arc.Tasks, 1)
+		assert.Len(t, arc.Labels, 1)
+		if assert.Len(t, arc.Comments, 2) {
+			assert.Equal(t, String("FILE"), arc.Comments[1].Attachment.FileName)
+		}
+		assert.Equal(t, map[int]Users{1: {{ID: 3, Name: "USER", Email: "user@example.com"}}}, arc.Collaborators)
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_Restore(t *testing.T) {
+	ctx := context.Background()
+	arc := `{
+		"version": 1,
+		"projects": [
+			{ "id": 1, "name": "INBOX", "inbox_project": true },
+			{ "id": 2, "name": "PROJECT", "color": 30 }
+		],
+		"sections": [{ "id": 10, "project_id": 2, "name": "SECTION", "order": 1 }],
+		"tasks": [{ "id": 100, "project_id": 2, "section_id": 10, "content": "TASK", "label_ids": [1000], "order": 1, "priority": 1 }],
+		"labels": [{ "id": 1000, "name": "LABEL" }],
+		"comments": [
+			{ "id": 4, "task_id": 100, "content": "COMMENT" },
+			{ "id": 5, "task_id": 999, "content": "ORPHAN COMMENT" }
+		]
+	}`
+
+	newMock := func() (*Client, *mockRestAPI) {
+		cl, api := newClientForTest()
+		get := func(url, body string) {
+			api.On("Do", &restRequest{
+				Context: ctx,
+				URL:     url,
+				Method:  http.MethodGet,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil)
+		}
+		get("https://api.todoist.com/rest/v1/labels", `[{ "id": 5000, "name": "LABEL" }]`)
+		get("https://api.todoist.com/rest/v1/projects", `[{ "id": 5001, "name": "Inbox", "inbox_project": true }]`)
+		get("https://api.todoist.com/rest/v1/sections", `[]`)
+		get("https://api.todoist.com/rest/v1/tasks", `[]`)
+		return cl, api
+	}
+
+	t.Run("should restore an archive with ID remapping", func(t *testing.T) {
+		cl, api := newMock()
+		post := func(url string, payload map[string]interface{}, body string) {
+			api.On("Do", &restRequest{
+				Context: ctx,
+				URL:     url,
+				Method:  http.MethodPost,
+				Payload: payload,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil).Once()
+		}
+		post("https://api.todoist.com/rest/v1/projects", map[string]interface{}{"name": "PROJECT", "color": Int(30), "favorite": Bool(false)}, `{ "id": 6000 }`)
+		post("https://api.todoist.com/rest/v1/sections", map[string]interface{}{"name": "SECTION", "project_id": 6000, "order": Int(1)}, `{ "id": 6010 }`)
+		post("https://api.todoist.com/rest/v1/tasks", map[string]interface{}{
+			"content":    "TASK",
+			"project_id": Int(6000),
+			"section_id": Int(6010),
+			"order":      Int(1),
+			"priority":   Int(1),
+			"label_ids":  Ints(5000),
+		}, `{ "id": 6100 }`)
+		post("https://api.todoist.com/rest/v1/comments", map[string]interface{}{"task_id": 6100, "content": "COMMENT"}, `{ "id": 6200 }`)
+
+		progress := []RestoreProgress{}
+		res, err := cl.Restore(ctx, strings.NewReader(arc), RestoreOptions{
+			Progress: func(p RestoreProgress) { progress = append(progress, p) },
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &RestoreResult{
+			Labels:   map[int]int{1000: 5000},
+			Projects: map[int]int{1: 5001, 2: 6000},
+			Sections: map[int]int{10: 6010},
+			Tasks:    map[int]int{100: 6100},
+			Comments: map[int]int{4: 6200},
+		}, res)
+		assert.Len(t, progress, 7)
+		assert.Equal(t, RestoreProgress{Stage: "comments", Done: 2, Total: 2}, progress[6])
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should reuse tasks and comments restored before", func(t *testing.T) {
+		cl, api := newClientForTest()
+		get := func(url, body string) {
+			api.On("Do", &restRequest{
+				Context: ctx,
+				URL:     url,
+				Method:  http.MethodGet,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil)
+		}
+		get("https://api.todoist.com/rest/v1/labels", `[{ "id": 5000, "name": "LABEL" }]`)
+		get("https://api.todoist.com/rest/v1/projects", `[{ "id": 5001, "name": "Inbox", "inbox_project": true }, { "id": 6000, "name": "PROJECT" }]`)
+		get("https://api.todoist.com/rest/v1/sections", `[{ "id": 6010, "project_id": 6000, "name": "SECTION" }]`)
+		get("https://api.todoist.com/rest/v1/tasks", `[{ "id": 6100, "project_id": 6000, "section_id": 6010, "content": "TASK" }]`)
+		get("https://api.todoist.com/rest/v1/comments?task_id=6100", `[{ "id": 6200, "task_id": 6100, "content": "COMMENT" }]`)
+
+		res, err := cl.Restore(ctx, strings.NewReader(arc), RestoreOptions{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &RestoreResult{
+			Labels:   map[int]int{1000: 5000},
+			Projects: map[int]int{1: 5001, 2: 6000},
+			Sections: map[int]int{10: 6010},
+			Tasks:    map[int]int{100: 6100},
+			Comments: map[int]int{4: 6200},
+		}, res)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should fail on conflict", func(t *testing.T) {
+		cl, api := newClientForTest()
+		api.On("Do", &restRequest{
+			Context: ctx,
+			URL:     "https://api.todoist.com/rest/v1/labels",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 5000, "name": "LABEL" }]`)}, nil)
+
+		_, err := cl.Restore(ctx, strings.NewReader(arc), RestoreOptions{Conflict: ConflictFail})
+
+		assert.ErrorIs(t, err, ErrRestoreConflict)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should return an error if the version is not supported", func(t *testing.T) {
+		cl, api := newClientForTest()
+
+		_, err := cl.Restore(ctx, strings.NewReader(`{ "version": 99 }`), RestoreOptions{})
+
+		assert.EqualError(t, err, "unsupported backup version: 99")
+		api.AssertExpectations(t)
+	})
+}
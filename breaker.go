@@ -0,0 +1,163 @@
+package todoist
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Returned when the circuit breaker is open and the request is not sent.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Options for the circuit breaker.
+type CircuitBreakerOptions struct {
+	// Number of consecutive failures (5xx responses or transport errors such as timeouts) to open the circuit.
+	// Default is 5.
+	FailureThreshold int
+	// Duration the circuit stays open before a half-open probe request is allowed.
+	// Default is 30 seconds.
+	OpenTimeout time.Duration
+	// Number of consecutive successful probe requests to close the circuit.
+	// Default is 1.
+	HalfOpenSuccesses int
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuitBreakerAPI struct {
+	restAPI restAPI
+	opts    CircuitBreakerOptions
+	now     func() time.Time
+
+	mu        sync.Mutex
+	state     circuitState
+	failures  int
+	successes int
+	openedAt  time.Time
+	probing   bool
+	// Incremented on every state change, so results of requests admitted in a previous state are ignored.
+	generation uint64
+}
+
+var _ restAPI = (*circuitBreakerAPI)(nil)
+
+func newCircuitBreakerAPI(api restAPI, opts *CircuitBreakerOptions) *circuitBreakerAPI {
+	o := *opts
+	if o.FailureThreshold <= 0 {
+		o.FailureThreshold = 5
+	}
+	if o.OpenTimeout <= 0 {
+		o.OpenTimeout = 30 * time.Second
+	}
+	if o.HalfOpenSuccesses <= 0 {
+		o.HalfOpenSuccesses = 1
+	}
+
+	return &circuitBreakerAPI{restAPI: api, opts: o, now: time.Now}
+}
+
+func (api *circuitBreakerAPI) Do(req *restRequest) (*restResponse, error) {
+	gen, err := api.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.restAPI.Do(req)
+	// a request canceled by the caller says nothing about the health of the API.
+	if errors.Is(err, context.Canceled) {
+		api.release(gen)
+		return nil, err
+	}
+	api.record(gen, err != nil || resp.StatusCode >= 500)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Returns the generation the request is admitted under.
+func (api *circuitBreakerAPI) allow() (uint64, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	switch api.state {
+	case circuitOpen:
+		if api.now().Before(api.openedAt.Add(api.opts.OpenTimeout)) {
+			return 0, ErrCircuitOpen
+		}
+		api.setState(circuitHalfOpen)
+		api.successes = 0
+		api.probing = true
+	case circuitHalfOpen:
+		// only one probe request at a time.
+		if api.probing {
+			return 0, ErrCircuitOpen
+		}
+		api.probing = true
+	}
+
+	return api.generation, nil
+}
+
+// Records the result of a request admitted under the generation.
+func (api *circuitBreakerAPI) record(gen uint64, failed bool) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	// the state has changed since the request was admitted.
+	if gen != api.generation {
+		return
+	}
+
+	switch api.state {
+	case circuitClosed:
+		if !failed {
+			api.failures = 0
+			return
+		}
+		api.failures++
+		if api.failures >= api.opts.FailureThreshold {
+			api.open()
+		}
+	case circuitHalfOpen:
+		api.probing = false
+		if failed {
+			api.open()
+			return
+		}
+		api.successes++
+		if api.successes >= api.opts.HalfOpenSuccesses {
+			api.setState(circuitClosed)
+			api.failures = 0
+		}
+	}
+}
+
+// Releases the probe slot of a request admitted under the generation without recording a result.
+func (api *circuitBreakerAPI) release(gen uint64) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if gen == api.generation && api.state == circuitHalfOpen {
+		api.probing = false
+	}
+}
+
+func (api *circuitBreakerAPI) open() {
+	api.setState(circuitOpen)
+	api.openedAt = api.now()
+	api.failures = 0
+}
+
+func (api *circuitBreakerAPI) setState(state circuitState) {
+	api.state = state
+	api.generation++
+}
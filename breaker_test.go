@@ -0,0 +1,158 @@
+package todoist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakerAPI_Do(t *testing.T) {
+	req := &restRequest{
+		URL:     "https://api.todoist.com/rest/v1/tasks",
+		Method:  http.MethodGet,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+	}
+	okResp := func(*restRequest) *restResponse {
+		return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader("[]")}
+	}
+	errResp := func(*restRequest) *restResponse {
+		return &restResponse{StatusCode: http.StatusServiceUnavailable, Body: strings.NewReader("ERROR_RESPONSE")}
+	}
+
+	newAPI := func() (*circuitBreakerAPI, *mockRestAPI, *time.Time) {
+		mapi := &mockRestAPI{}
+		now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		api := newCircuitBreakerAPI(mapi, &CircuitBreakerOptions{FailureThreshold: 2, OpenTimeout: time.Minute})
+		api.now = func() time.Time { return now }
+		return api, mapi, &now
+	}
+
+	t.Run("should open after consecutive failures", func(t *testing.T) {
+		api, mapi, _ := newAPI()
+		mapi.On("Do", req).Return(errResp, nil).Once()
+		mapi.On("Do", req).Return(nil, errors.New("timeout")).Once()
+
+		resp, err := api.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		_, err = api.Do(req)
+		assert.EqualError(t, err, "timeout")
+
+		_, err = api.Do(req)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should not open if failures are not consecutive", func(t *testing.T) {
+		api, mapi, _ := newAPI()
+		mapi.On("Do", req).Return(errResp, nil).Once()
+		mapi.On("Do", req).Return(okResp, nil).Once()
+		mapi.On("Do", req).Return(errResp, nil).Once()
+		mapi.On("Do", req).Return(okResp, nil).Once()
+
+		for i := 0; i < 4; i++ {
+			_, err := api.Do(req)
+			assert.NoError(t, err)
+		}
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should close after a successful half-open probe", func(t *testing.T) {
+		api, mapi, now := newAPI()
+		mapi.On("Do", req).Return(errResp, nil).Twice()
+		mapi.On("Do", req).Return(okResp, nil).Twice()
+
+		_, _ = api.Do(req)
+		_, _ = api.Do(req)
+		_, err := api.Do(req)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		*now = now.Add(time.Minute)
+		_, err = api.Do(req)
+		assert.NoError(t, err)
+		_, err = api.Do(req)
+		assert.NoError(t, err)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should reopen after a failed half-open probe", func(t *testing.T) {
+		api, mapi, now := newAPI()
+		mapi.On("Do", req).Return(errResp, nil).Times(3)
+
+		_, _ = api.Do(req)
+		_, _ = api.Do(req)
+
+		*now = now.Add(time.Minute)
+		_, err := api.Do(req)
+		assert.NoError(t, err)
+
+		_, err = api.Do(req)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should not count canceled requests as failures", func(t *testing.T) {
+		api, mapi, _ := newAPI()
+		mapi.On("Do", req).Return(nil, context.Canceled).Twice()
+		mapi.On("Do", req).Return(okResp, nil).Once()
+
+		for i := 0; i < 2; i++ {
+			_, err := api.Do(req)
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+		_, err := api.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, circuitClosed, api.state)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should release the half-open probe slot of a canceled request", func(t *testing.T) {
+		api, mapi, now := newAPI()
+		mapi.On("Do", req).Return(errResp, nil).Twice()
+		mapi.On("Do", req).Return(nil, fmt.Errorf("get: %w", context.Canceled)).Once()
+		mapi.On("Do", req).Return(okResp, nil).Once()
+
+		_, _ = api.Do(req)
+		_, _ = api.Do(req)
+
+		*now = now.Add(time.Minute)
+		_, err := api.Do(req)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, circuitHalfOpen, api.state)
+
+		_, err = api.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, circuitClosed, api.state)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should ignore results of requests admitted before the half-open state", func(t *testing.T) {
+		api, _, now := newAPI()
+
+		slowGen, err := api.allow()
+		assert.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			gen, err := api.allow()
+			assert.NoError(t, err)
+			api.record(gen, true)
+		}
+
+		*now = now.Add(time.Minute)
+		probeGen, err := api.allow()
+		assert.NoError(t, err)
+
+		api.record(slowGen, false)
+		_, err = api.allow()
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Equal(t, circuitHalfOpen, api.state)
+
+		api.record(probeGen, false)
+		assert.Equal(t, circuitClosed, api.state)
+	})
+}
@@ -0,0 +1,322 @@
+package todoist
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const (
+	defaultBulkConcurrency int = 4
+	// Maximum length of the encoded ids query parameter per request.
+	maxIDsQueryLength int = 1500
+)
+
+// Options for bulk operations.
+type BulkOptions struct {
+	// Maximum number of concurrent requests.
+	// Default is 4.
+	Concurrency *int
+	// Whether to send a REST API request per item instead of batching Sync API commands.
+	// This only applies to bulk write operations.
+	DisableSync *bool
+}
+
+// Result of a bulk write operation per task ID.
+// A nil error means the operation succeeded for the task.
+type BulkReport map[int]error
+
+// Returns a BulkError with the failed tasks, or nil if all tasks succeeded.
+func (r BulkReport) Err() error {
+	berr := BulkError{Errors: map[int]error{}}
+	for id, err := range r {
+		if err != nil {
+			berr.Errors[id] = err
+		}
+	}
+
+	if len(berr.Errors) > 0 {
+		return berr
+	}
+	return nil
+}
+
+// Error returned when some of the items of a bulk operation fail.
+// Results of the other items are still returned.
+type BulkError struct {
+	// Errors per ID.
+	Errors map[int]error
+}
+
+func (err BulkError) Error() string {
+	ids := make([]int, 0, len(err.Errors))
+	for id := range err.Errors {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return fmt.Sprintf("bulk error: %d failed (first: %d: %s)", len(ids), ids[0], err.Errors[ids[0]])
+}
+
+// Gets comments for each task concurrently.
+func (cl *Client) GetTaskCommentsBulk(ctx context.Context, taskIDs []int) (map[int]Comments, error) {
+	return cl.GetTaskCommentsBulkWithOptions(ctx, taskIDs, nil)
+}
+
+// Gets comments for each task concurrently with options.
+func (cl *Client) GetTaskCommentsBulkWithOptions(ctx context.Context, taskIDs []int, opts *BulkOptions) (map[int]Comments, error) {
+	c := cl.WithContext(ctx)
+	cmts := make([]Comments, len(taskIDs))
+	errs := runBulk(ctx, len(taskIDs), opts, func(i int) error {
+		cmt, err := c.GetTaskComments(taskIDs[i])
+		cmts[i] = cmt
+		return err
+	})
+
+	m := map[int]Comments{}
+	berr := BulkError{Errors: map[int]error{}}
+	for i, id := range taskIDs {
+		if errs[i] != nil {
+			berr.Errors[id] = errs[i]
+			continue
+		}
+		m[id] = cmts[i]
+	}
+
+	if len(berr.Errors) > 0 {
+		return m, berr
+	}
+	return m, nil
+}
+
+// Gets active tasks by IDs.
+// IDs are split into chunks to keep request URLs short and the chunks are fetched concurrently.
+func (cl *Client) GetTasksByIDs(ctx context.Context, ids []int) (Tasks, error) {
+	return cl.GetTasksByIDsWithOptions(ctx, ids, nil)
+}
+
+// Gets active tasks by IDs with options.
+func (cl *Client) GetTasksByIDsWithOptions(ctx context.Context, ids []int, opts *BulkOptions) (Tasks, error) {
+	c := cl.WithContext(ctx)
+	chunks := chunkIDs(ids, maxIDsQueryLength)
+	results := make([]Tasks, len(chunks))
+	errs := runBulk(ctx, len(chunks), opts, func(i int) error {
+		ts, err := c.GetTasksWithOptions(&GetTasksOptions{IDs: &chunks[i]})
+		results[i] = ts
+		return err
+	})
+
+	tasks := Tasks{}
+	berr := BulkError{Errors: map[int]error{}}
+	for i, chunk := range chunks {
+		if errs[i] != nil {
+			for _, id := range chunk {
+				berr.Errors[id] = errs[i]
+			}
+			continue
+		}
+		tasks = append(tasks, results[i]...)
+	}
+
+	if len(berr.Errors) > 0 {
+		return tasks, berr
+	}
+	return tasks, nil
+}
+
+// Closes tasks in bulk.
+func (cl *Client) BulkCloseTasks(ctx context.Context, ids []int) (BulkReport, error) {
+	return cl.BulkCloseTasksWithOptions(ctx, ids, nil)
+}
+
+// Closes tasks in bulk with options.
+func (cl *Client) BulkCloseTasksWithOptions(ctx context.Context, ids []int, opts *BulkOptions) (BulkReport, error) {
+	return cl.bulkWrite(ctx, ids, opts,
+		func(id int) (*syncCommand, error) {
+			return cl.newCommand("item_close", map[string]interface{}{"id": id}), nil
+		},
+		(*Client).CloseTask,
+	)
+}
+
+// Moves tasks to another project, section or parent task in bulk.
+// Moving tasks is only supported by the Sync API, so DisableSync has no effect.
+func (cl *Client) BulkMoveTasks(ctx context.Context, ids []int, target MoveTarget) (BulkReport, error) {
+	return cl.BulkMoveTasksWithOptions(ctx, ids, target, nil)
+}
+
+// Moves tasks to another project, section or parent task in bulk with options.
+func (cl *Client) BulkMoveTasksWithOptions(ctx context.Context, ids []int, target MoveTarget, opts *BulkOptions) (BulkReport, error) {
+	if _, err := target.args(0); err != nil {
+		return nil, err
+	}
+
+	return cl.bulkWrite(ctx, ids, opts,
+		func(id int) (*syncCommand, error) {
+			args, err := target.args(id)
+			if err != nil {
+				return nil, err
+			}
+			return cl.newCommand("item_move", args), nil
+		},
+		nil,
+	)
+}
+
+// Sets labels of tasks in bulk.
+func (cl *Client) BulkSetLabels(ctx context.Context, ids []int, labelIDs []int) (BulkReport, error) {
+	return cl.BulkSetLabelsWithOptions(ctx, ids, labelIDs, nil)
+}
+
+// Sets labels of tasks in bulk with options.
+func (cl *Client) BulkSetLabelsWithOptions(ctx context.Context, ids []int, labelIDs []int, opts *BulkOptions) (BulkReport, error) {
+	return cl.bulkWrite(ctx, ids, opts,
+		func(id int) (*syncCommand, error) {
+			return cl.newCommand("item_update", map[string]interface{}{"id": id, "labels": labelIDs}), nil
+		},
+		func(c *Client, id int) error {
+			return c.UpdateTaskWithOptions(id, &UpdateTaskOptions{LabelIDs: &labelIDs})
+		},
+	)
+}
+
+// Sets priority of tasks in bulk.
+func (cl *Client) BulkSetPriority(ctx context.Context, ids []int, priority int) (BulkReport, error) {
+	return cl.BulkSetPriorityWithOptions(ctx, ids, priority, nil)
+}
+
+// Sets priority of tasks in bulk with options.
+func (cl *Client) BulkSetPriorityWithOptions(ctx context.Context, ids []int, priority int, opts *BulkOptions) (BulkReport, error) {
+	return cl.bulkWrite(ctx, ids, opts,
+		func(id int) (*syncCommand, error) {
+			return cl.newCommand("item_update", map[string]interface{}{"id": id, "priority": priority}), nil
+		},
+		func(c *Client, id int) error {
+			return c.UpdateTaskWithOptions(id, &UpdateTaskOptions{Priority: &priority})
+		},
+	)
+}
+
+// Runs a write operation for each task, batching Sync API commands.
+// If Sync API is disabled or a batch request fails, it falls back to rest for each task of the batch if rest is not nil.
+// Requests are sent with ctx.
+func (cl *Client) bulkWrite(ctx context.Context, ids []int, opts *BulkOptions, command func(id int) (*syncCommand, error), rest func(c *Client, id int) error) (BulkReport, error) {
+	c := cl.WithContext(ctx)
+	report := BulkReport{}
+	useSync := opts == nil || opts.DisableSync == nil || !*opts.DisableSync || rest == nil
+
+	restFallback := func(ids []int, cause error) {
+		if rest == nil {
+			for _, id := range ids {
+				report[id] = cause
+			}
+			return
+		}
+		errs := runBulk(ctx, len(ids), opts, func(i int) error { return rest(c, ids[i]) })
+		for i, id := range ids {
+			report[id] = errs[i]
+		}
+	}
+
+	if !useSync {
+		restFallback(ids, nil)
+		return report, report.Err()
+	}
+
+	for start := 0; start < len(ids); start += maxSyncCommands {
+		end := start + maxSyncCommands
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+
+		if err := ctx.Err(); err != nil {
+			for _, id := range chunk {
+				report[id] = err
+			}
+			continue
+		}
+
+		cmds := []*syncCommand{}
+		cmdIDs := []int{}
+		for _, id := range chunk {
+			cmd, err := command(id)
+			if err != nil {
+				report[id] = err
+				continue
+			}
+			cmds = append(cmds, cmd)
+			cmdIDs = append(cmdIDs, id)
+		}
+
+		resp, err := c.sync(cmds, nil)
+		if err != nil {
+			restFallback(cmdIDs, err)
+			continue
+		}
+		for i, cmd := range cmds {
+			report[cmdIDs[i]] = resp.commandError(cmd)
+		}
+	}
+
+	return report, report.Err()
+}
+
+// Runs fn for each index with a bounded number of workers and returns errors per index.
+// Indexes not started before ctx is done get ctx.Err().
+func runBulk(ctx context.Context, n int, opts *BulkOptions, fn func(i int) error) []error {
+	concurrency := defaultBulkConcurrency
+	if opts != nil && opts.Concurrency != nil && *opts.Concurrency > 0 {
+		concurrency = *opts.Concurrency
+	}
+
+	errs := make([]error, n)
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
+
+// Splits IDs into chunks whose comma-separated and URL-encoded length does not exceed max.
+func chunkIDs(ids []int, max int) [][]int {
+	chunks := [][]int{}
+	chunk := []int{}
+	l := 0
+	for _, id := range ids {
+		// "%2C" separator is 3 characters.
+		idl := len(strconv.Itoa(id)) + 3
+		if len(chunk) > 0 && l+idl > max {
+			chunks = append(chunks, chunk)
+			chunk = []int{}
+			l = 0
+		}
+		chunk = append(chunk, id)
+		l += idl
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
@@ -0,0 +1,240 @@
+package todoist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetTaskCommentsBulk(t *testing.T) {
+	t.Run("should return comments per task with per-ID errors", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		for _, id := range []int{1, 2} {
+			api.On("Do", &restRequest{
+				Context: ctx,
+				URL:     fmt.Sprintf("https://api.todoist.com/rest/v1/comments?task_id=%d", id),
+				Method:  http.MethodGet,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			}).Return(&restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(fmt.Sprintf(`[{ "id": %d, "content": "COMMENT" }]`, id*10)),
+			}, nil)
+		}
+		api.On("Do", &restRequest{
+			Context: ctx,
+			URL:     "https://api.todoist.com/rest/v1/comments?task_id=3",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{
+			StatusCode: http.StatusBadRequest,
+			Body:       strings.NewReader("ERROR_RESPONSE"),
+		}, nil)
+
+		cmts, err := cl.GetTaskCommentsBulkWithOptions(ctx, []int{1, 2, 3}, &BulkOptions{Concurrency: Int(2)})
+
+		assert.Equal(t, map[int]Comments{
+			1: {{ID: 10, Content: "COMMENT"}},
+			2: {{ID: 20, Content: "COMMENT"}},
+		}, cmts)
+		if assert.IsType(t, BulkError{}, err) {
+			berr := err.(BulkError)
+			assert.Len(t, berr.Errors, 1)
+			assert.IsType(t, RequestError{}, berr.Errors[3])
+		}
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should not send requests if the context is canceled", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		cmts, err := cl.GetTaskCommentsBulk(ctx, []int{1, 2})
+
+		assert.Empty(t, cmts)
+		if assert.IsType(t, BulkError{}, err) {
+			assert.ErrorIs(t, err.(BulkError).Errors[1], context.Canceled)
+			assert.ErrorIs(t, err.(BulkError).Errors[2], context.Canceled)
+		}
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_GetTasksByIDs(t *testing.T) {
+	t.Run("should return tasks", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		api.On("Do", &restRequest{
+			Context: ctx,
+			URL:     "https://api.todoist.com/rest/v1/tasks?ids=1%2C2",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`[{ "id": 1, "content": "TASK_1" }, { "id": 2, "content": "TASK_2" }]`),
+		}, nil)
+
+		tasks, err := cl.GetTasksByIDs(ctx, []int{1, 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Tasks{{ID: 1, Content: "TASK_1"}, {ID: 2, Content: "TASK_2"}}, tasks)
+		api.AssertExpectations(t)
+	})
+}
+
+func Test_chunkIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []int
+		max  int
+		want [][]int
+	}{
+		{name: "should return no chunks", ids: []int{}, max: 10, want: [][]int{}},
+		{name: "should return a chunk", ids: []int{1, 2, 3}, max: 12, want: [][]int{{1, 2, 3}}},
+		{name: "should split ids", ids: []int{1, 22, 333, 4}, max: 10, want: [][]int{{1, 22}, {333, 4}}},
+		{name: "should not return empty chunks", ids: []int{123456789}, max: 5, want: [][]int{{123456789}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, chunkIDs(tt.ids, tt.max))
+		})
+	}
+}
+
+func TestClient_BulkCloseTasks(t *testing.T) {
+	t.Run("should close tasks with Sync API commands", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		api.On("Do", withContextForTest(ctx, newSyncCommandsRequestForTest(
+			&syncCommand{Type: "item_close", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}},
+			&syncCommand{Type: "item_close", UUID: "UUID_2", Args: map[string]interface{}{"id": 2}},
+		))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok", "UUID_2": { "error_code": 20, "error": "Item not found" } } }`),
+		}, nil)
+
+		report, err := cl.BulkCloseTasks(ctx, []int{1, 2})
+
+		assert.Equal(t, BulkReport{1: nil, 2: SyncError{Code: 20, Message: "Item not found"}}, report)
+		assert.Equal(t, BulkError{Errors: map[int]error{2: SyncError{Code: 20, Message: "Item not found"}}}, err)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should fall back to REST API if the Sync API request fails", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		api.On("Do", withContextForTest(ctx, newSyncCommandsRequestForTest(
+			&syncCommand{Type: "item_close", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}},
+		))).Return(&restResponse{StatusCode: http.StatusServiceUnavailable, Body: strings.NewReader("ERROR_RESPONSE")}, nil)
+		api.On("Do", &restRequest{
+			Context: ctx,
+			URL:     "https://api.todoist.com/rest/v1/tasks/1/close",
+			Method:  http.MethodPost,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{StatusCode: http.StatusNoContent, Body: strings.NewReader("")}, nil)
+
+		report, err := cl.BulkCloseTasks(ctx, []int{1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkReport{1: nil}, report)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should use REST API if Sync API is disabled", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		api.On("Do", &restRequest{
+			Context: ctx,
+			URL:     "https://api.todoist.com/rest/v1/tasks/1/close",
+			Method:  http.MethodPost,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{StatusCode: http.StatusBadRequest, Body: strings.NewReader("ERROR_RESPONSE")}, nil)
+
+		report, err := cl.BulkCloseTasksWithOptions(ctx, []int{1}, &BulkOptions{DisableSync: Bool(true)})
+
+		assert.IsType(t, BulkError{}, err)
+		assert.IsType(t, RequestError{}, report[1])
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_BulkMoveTasks(t *testing.T) {
+	t.Run("should move tasks", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		api.On("Do", withContextForTest(ctx, newSyncCommandsRequestForTest(
+			&syncCommand{Type: "item_move", UUID: "UUID_1", Args: map[string]interface{}{"id": 1, "section_id": Int(10)}},
+		))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+		}, nil)
+
+		report, err := cl.BulkMoveTasks(ctx, []int{1}, MoveTarget{SectionID: Int(10)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkReport{1: nil}, report)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should return an error if the target is invalid", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		report, err := cl.BulkMoveTasks(ctx, []int{1}, MoveTarget{ProjectID: Int(1), SectionID: Int(2)})
+
+		assert.Nil(t, report)
+		assert.ErrorIs(t, err, ErrInvalidMoveTarget)
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_BulkSetLabels(t *testing.T) {
+	t.Run("should set labels", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		api.On("Do", withContextForTest(ctx, newSyncCommandsRequestForTest(
+			&syncCommand{Type: "item_update", UUID: "UUID_1", Args: map[string]interface{}{"id": 1, "labels": []int{2, 3}}},
+		))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+		}, nil)
+
+		report, err := cl.BulkSetLabels(ctx, []int{1}, []int{2, 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkReport{1: nil}, report)
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_BulkSetPriority(t *testing.T) {
+	t.Run("should set priority", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx := context.Background()
+
+		api.On("Do", withContextForTest(ctx, newSyncCommandsRequestForTest(
+			&syncCommand{Type: "item_update", UUID: "UUID_1", Args: map[string]interface{}{"id": 1, "priority": 4}},
+		))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+		}, nil)
+
+		report, err := cl.BulkSetPriority(ctx, []int{1}, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkReport{1: nil}, report)
+		api.AssertExpectations(t)
+	})
+}
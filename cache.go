@@ -0,0 +1,298 @@
+package todoist
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default TTLs of cached responses per resource type.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"projects": 5 * time.Minute,
+	"labels":   5 * time.Minute,
+	"sections": 5 * time.Minute,
+}
+
+// Options for caching responses.
+type CacheOptions struct {
+	// Storage for cached responses.
+	// If not set, responses are cached in memory.
+	Store CacheStore
+	// TTLs of cached responses per resource type (e.g. "projects", "labels", "sections").
+	// Responses of resource types not listed here are not cached.
+	// If not set, DefaultCacheTTLs is used.
+	TTLs map[string]time.Duration
+}
+
+// Cached response.
+type CacheEntry struct {
+	// Response body.
+	Body []byte `json:"body"`
+	// ETag header of the response.
+	ETag string `json:"etag,omitempty"`
+	// Last-Modified header of the response.
+	LastModified string `json:"last_modified,omitempty"`
+	// Time when the response was stored or last revalidated.
+	StoredAt time.Time `json:"stored_at"`
+}
+
+// Storage for cached responses.
+// Keys include a hash of the API token, so a store can be shared by clients of different accounts.
+type CacheStore interface {
+	// Gets a cached response of the resource type.
+	// Returns nil if there is no cached response.
+	Get(resource, key string) (*CacheEntry, error)
+	// Stores a response of the resource type.
+	Set(resource, key string, entry *CacheEntry) error
+	// Removes all cached responses of the resource type.
+	Purge(resource string) error
+}
+
+// In-memory cache storage.
+type MemoryCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]map[string]*CacheEntry
+}
+
+var _ CacheStore = (*MemoryCacheStore)(nil)
+
+// Returns new in-memory cache storage.
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{entries: map[string]map[string]*CacheEntry{}}
+}
+
+// Gets a cached response of the resource type.
+func (s *MemoryCacheStore) Get(resource, key string) (*CacheEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.entries[resource][key], nil
+}
+
+// Stores a response of the resource type.
+func (s *MemoryCacheStore) Set(resource, key string, entry *CacheEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[resource]; !ok {
+		s.entries[resource] = map[string]*CacheEntry{}
+	}
+	s.entries[resource][key] = entry
+	return nil
+}
+
+// Removes all cached responses of the resource type.
+func (s *MemoryCacheStore) Purge(resource string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, resource)
+	return nil
+}
+
+// File-based cache storage.
+// Each response is stored as a JSON file under a directory per resource type.
+type FileCacheStore struct {
+	dir string
+}
+
+var _ CacheStore = (*FileCacheStore)(nil)
+
+// Returns new file-based cache storage in the directory.
+func NewFileCacheStore(dir string) *FileCacheStore {
+	return &FileCacheStore{dir: dir}
+}
+
+// Gets a cached response of the resource type.
+func (s *FileCacheStore) Get(resource, key string) (*CacheEntry, error) {
+	b, err := os.ReadFile(s.path(resource, key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entry := CacheEntry{}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// Stores a response of the resource type.
+func (s *FileCacheStore) Set(resource, key string, entry *CacheEntry) error {
+	if err := os.MkdirAll(filepath.Join(s.dir, resource), 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path(resource, key), b, 0o600)
+}
+
+// Removes all cached responses of the resource type.
+func (s *FileCacheStore) Purge(resource string) error {
+	return os.RemoveAll(filepath.Join(s.dir, resource))
+}
+
+func (s *FileCacheStore) path(resource, key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, resource, hex.EncodeToString(h[:])+".json")
+}
+
+type cachingAPI struct {
+	restAPI restAPI
+	store   CacheStore
+	ttls    map[string]time.Duration
+	now     func() time.Time
+}
+
+var _ restAPI = (*cachingAPI)(nil)
+
+func newCachingAPI(api restAPI, opts *CacheOptions) *cachingAPI {
+	store := opts.Store
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+	ttls := opts.TTLs
+	if ttls == nil {
+		ttls = DefaultCacheTTLs
+	}
+
+	return &cachingAPI{restAPI: api, store: store, ttls: ttls, now: time.Now}
+}
+
+func (api *cachingAPI) Do(req *restRequest) (*restResponse, error) {
+	res := resourceSegments(req.URL)[0]
+
+	if req.Method != http.MethodGet {
+		resp, err := api.restAPI.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if 200 <= resp.StatusCode && resp.StatusCode <= 299 {
+			for _, res := range api.writtenResources(req) {
+				if err := api.store.Purge(res); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return resp, nil
+	}
+
+	ttl, ok := api.ttls[res]
+	if !ok {
+		return api.restAPI.Do(req)
+	}
+
+	key := cacheKey(req)
+	entry, err := api.store.Get(res, key)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil && api.now().Before(entry.StoredAt.Add(ttl)) {
+		return entry.response(), nil
+	}
+
+	condreq := req
+	if entry != nil && (entry.ETag != "" || entry.LastModified != "") {
+		condreq = &restRequest{Context: req.Context, URL: req.URL, Method: req.Method, Payload: req.Payload, Headers: map[string]string{}}
+		for k, v := range req.Headers {
+			condreq.Headers[k] = v
+		}
+		if entry.ETag != "" {
+			condreq.Headers["If-None-Match"] = entry.ETag
+		}
+		if entry.LastModified != "" {
+			condreq.Headers["If-Modified-Since"] = entry.LastModified
+		}
+	}
+
+	resp, err := api.restAPI.Do(condreq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		// the entry may be shared with the store, so store a copy.
+		revalidated := *entry
+		revalidated.StoredAt = api.now()
+		if err := api.store.Set(res, key, &revalidated); err != nil {
+			return nil, err
+		}
+		return revalidated.response(), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = bytes.NewReader(b)
+
+	entry = &CacheEntry{Body: b, StoredAt: api.now()}
+	if resp.Header != nil {
+		entry.ETag = resp.Header.Get("ETag")
+		entry.LastModified = resp.Header.Get("Last-Modified")
+	}
+	if err := api.store.Set(res, key, entry); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Returns the key of a cached response, which is the URL and a hash of the Authorization header.
+func cacheKey(req *restRequest) string {
+	h := sha256.Sum256([]byte(req.Headers["Authorization"]))
+	return req.URL + " " + hex.EncodeToString(h[:8])
+}
+
+// Returns resource types whose cached responses are invalidated by a write request.
+// Sync API commands invalidate the resources of their command types, and unknown command types invalidate all resources.
+func (api *cachingAPI) writtenResources(req *restRequest) []string {
+	cmds, ok := req.Payload["commands"].([]*syncCommand)
+	if !strings.HasPrefix(requestPath(req.URL), "/sync/") || !ok {
+		return []string{resourceSegments(req.URL)[0]}
+	}
+
+	resources := []string{}
+	seen := map[string]bool{}
+	for _, cmd := range cmds {
+		res, _, _ := strings.Cut(commandOperation(cmd.Type), ".")
+		if res == "sync" {
+			resources = []string{}
+			for res := range api.ttls {
+				resources = append(resources, res)
+			}
+			return resources
+		}
+		if !seen[res] {
+			seen[res] = true
+			resources = append(resources, res)
+		}
+	}
+
+	return resources
+}
+
+func (entry *CacheEntry) response() *restResponse {
+	return &restResponse{StatusCode: http.StatusOK, Body: bytes.NewReader(entry.Body)}
+}
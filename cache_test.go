@@ -0,0 +1,263 @@
+package todoist
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newCachingAPIForTest(store CacheStore) (*cachingAPI, *mockRestAPI, *time.Time) {
+	mapi := &mockRestAPI{}
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	api := newCachingAPI(mapi, &CacheOptions{Store: store})
+	api.now = func() time.Time { return now }
+	return api, mapi, &now
+}
+
+func readBody(t *testing.T, resp *restResponse) string {
+	b, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+func TestCachingAPI_Do(t *testing.T) {
+	getReq := &restRequest{
+		URL:     "https://api.todoist.com/rest/v1/projects",
+		Method:  http.MethodGet,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+	}
+
+	t.Run("should return a cached response within TTL", func(t *testing.T) {
+		api, mapi, _ := newCachingAPIForTest(nil)
+		mapi.On("Do", getReq).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 1 }]`)}, nil).Once()
+
+		resp, err := api.Do(getReq)
+		assert.NoError(t, err)
+		assert.Equal(t, `[{ "id": 1 }]`, readBody(t, resp))
+
+		resp, err = api.Do(getReq)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `[{ "id": 1 }]`, readBody(t, resp))
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should revalidate an expired response with ETag", func(t *testing.T) {
+		store := NewMemoryCacheStore()
+		api, mapi, now := newCachingAPIForTest(store)
+		mapi.On("Do", getReq).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": []string{`"ETAG"`}, "Last-Modified": []string{"LAST_MODIFIED"}},
+			Body:       strings.NewReader(`[{ "id": 1 }]`),
+		}, nil).Once()
+		mapi.On("Do", &restRequest{
+			URL:    getReq.URL,
+			Method: http.MethodGet,
+			Headers: map[string]string{
+				"Authorization":     "Bearer TOKEN",
+				"If-None-Match":     `"ETAG"`,
+				"If-Modified-Since": "LAST_MODIFIED",
+			},
+		}).Return(&restResponse{StatusCode: http.StatusNotModified, Body: strings.NewReader("")}, nil).Once()
+
+		_, err := api.Do(getReq)
+		assert.NoError(t, err)
+		stored, _ := store.Get("projects", cacheKey(getReq))
+		storedAt := stored.StoredAt
+
+		*now = now.Add(10 * time.Minute)
+		resp, err := api.Do(getReq)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `[{ "id": 1 }]`, readBody(t, resp))
+		assert.Equal(t, storedAt, stored.StoredAt)
+		revalidated, _ := store.Get("projects", cacheKey(getReq))
+		assert.Equal(t, *now, revalidated.StoredAt)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should invalidate cached responses on write", func(t *testing.T) {
+		api, mapi, _ := newCachingAPIForTest(nil)
+		postReq := &restRequest{
+			URL:     "https://api.todoist.com/rest/v1/projects/1",
+			Method:  http.MethodPost,
+			Payload: map[string]interface{}{"name": "PROJECT"},
+			Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+		}
+		mapi.On("Do", getReq).Return(func(*restRequest) *restResponse {
+			return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 1 }]`)}
+		}, nil).Twice()
+		mapi.On("Do", postReq).Return(&restResponse{StatusCode: http.StatusNoContent, Body: strings.NewReader("")}, nil).Once()
+
+		_, err := api.Do(getReq)
+		assert.NoError(t, err)
+		_, err = api.Do(postReq)
+		assert.NoError(t, err)
+		_, err = api.Do(getReq)
+		assert.NoError(t, err)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should invalidate cached responses of resources written by Sync API commands", func(t *testing.T) {
+		api, mapi, _ := newCachingAPIForTest(nil)
+		sectionsReq := &restRequest{
+			URL:     "https://api.todoist.com/rest/v1/sections",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}
+		syncReq := &restRequest{
+			URL:     "https://api.todoist.com/sync/v8/sync",
+			Method:  http.MethodPost,
+			Payload: map[string]interface{}{"commands": []*syncCommand{{Type: "project_archive", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}}}},
+			Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+		}
+		mapi.On("Do", getReq).Return(func(*restRequest) *restResponse {
+			return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 1 }]`)}
+		}, nil).Twice()
+		mapi.On("Do", sectionsReq).Return(func(*restRequest) *restResponse {
+			return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[]`)}
+		}, nil).Once()
+		mapi.On("Do", syncReq).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`)}, nil).Once()
+
+		_, err := api.Do(getReq)
+		assert.NoError(t, err)
+		_, err = api.Do(sectionsReq)
+		assert.NoError(t, err)
+		_, err = api.Do(syncReq)
+		assert.NoError(t, err)
+		_, err = api.Do(getReq)
+		assert.NoError(t, err)
+		_, err = api.Do(sectionsReq)
+		assert.NoError(t, err)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should invalidate all cached responses on unknown Sync API commands", func(t *testing.T) {
+		api, mapi, _ := newCachingAPIForTest(nil)
+		syncReq := &restRequest{
+			URL:     "https://api.todoist.com/sync/v8/sync",
+			Method:  http.MethodPost,
+			Payload: map[string]interface{}{"commands": []*syncCommand{{Type: "user_update", UUID: "UUID_1", Args: map[string]interface{}{}}}},
+			Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+		}
+		mapi.On("Do", getReq).Return(func(*restRequest) *restResponse {
+			return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 1 }]`)}
+		}, nil).Twice()
+		mapi.On("Do", syncReq).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`)}, nil).Once()
+
+		_, err := api.Do(getReq)
+		assert.NoError(t, err)
+		_, err = api.Do(syncReq)
+		assert.NoError(t, err)
+		_, err = api.Do(getReq)
+		assert.NoError(t, err)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should not share cached responses between tokens", func(t *testing.T) {
+		api, mapi, _ := newCachingAPIForTest(nil)
+		otherReq := &restRequest{
+			URL:     getReq.URL,
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer OTHER_TOKEN"},
+		}
+		mapi.On("Do", getReq).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 1 }]`)}, nil).Once()
+		mapi.On("Do", otherReq).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 2 }]`)}, nil).Once()
+
+		resp, err := api.Do(getReq)
+		assert.NoError(t, err)
+		assert.Equal(t, `[{ "id": 1 }]`, readBody(t, resp))
+		resp, err = api.Do(otherReq)
+		assert.NoError(t, err)
+		assert.Equal(t, `[{ "id": 2 }]`, readBody(t, resp))
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should not record revalidation as an error", func(t *testing.T) {
+		mapi := &mockRestAPI{}
+		m := newRecordingMeter()
+		tr := &recordingTracer{}
+		now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		api := newCachingAPI(newTracingAPI(mapi, tr, m), &CacheOptions{})
+		api.now = func() time.Time { return now }
+		mapi.On("Do", mock.MatchedBy(func(r *restRequest) bool { return r.Headers["If-None-Match"] == "" })).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": []string{`"ETAG"`}},
+			Body:       strings.NewReader(`[{ "id": 1 }]`),
+		}, nil).Once()
+		mapi.On("Do", mock.MatchedBy(func(r *restRequest) bool { return r.Headers["If-None-Match"] == `"ETAG"` })).
+			Return(&restResponse{StatusCode: http.StatusNotModified, Body: strings.NewReader("")}, nil).Once()
+
+		_, err := api.Do(getReq)
+		assert.NoError(t, err)
+		now = now.Add(10 * time.Minute)
+		resp, err := api.Do(getReq)
+		assert.NoError(t, err)
+
+		assert.Equal(t, `[{ "id": 1 }]`, readBody(t, resp))
+		assert.Equal(t, int64(2), m.counters[MetricRequests])
+		assert.Equal(t, int64(0), m.counters[MetricErrors])
+		if assert.Len(t, tr.spans, 2) {
+			assert.Empty(t, tr.spans[1].errs)
+		}
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should not cache resources without TTL", func(t *testing.T) {
+		api, mapi, _ := newCachingAPIForTest(nil)
+		req := &restRequest{
+			URL:     "https://api.todoist.com/rest/v1/tasks",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}
+		mapi.On("Do", req).Return(func(*restRequest) *restResponse {
+			return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[]`)}
+		}, nil).Twice()
+
+		_, err := api.Do(req)
+		assert.NoError(t, err)
+		_, err = api.Do(req)
+		assert.NoError(t, err)
+		mapi.AssertExpectations(t)
+	})
+
+	t.Run("should not cache error responses", func(t *testing.T) {
+		api, mapi, _ := newCachingAPIForTest(nil)
+		mapi.On("Do", getReq).Return(func(*restRequest) *restResponse {
+			return &restResponse{StatusCode: http.StatusBadRequest, Body: strings.NewReader("ERROR_RESPONSE")}
+		}, nil).Twice()
+
+		resp, err := api.Do(getReq)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		_, err = api.Do(getReq)
+		assert.NoError(t, err)
+		mapi.AssertExpectations(t)
+	})
+}
+
+func TestFileCacheStore(t *testing.T) {
+	t.Run("should store, get and purge entries", func(t *testing.T) {
+		s := NewFileCacheStore(t.TempDir())
+		entry := &CacheEntry{Body: []byte("BODY"), ETag: "ETAG", StoredAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+		got, err := s.Get("projects", "KEY")
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+
+		assert.NoError(t, s.Set("projects", "KEY", entry))
+		got, err = s.Get("projects", "KEY")
+		assert.NoError(t, err)
+		assert.Equal(t, entry, got)
+
+		assert.NoError(t, s.Purge("projects"))
+		got, err = s.Get("projects", "KEY")
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
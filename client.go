@@ -1,18 +1,22 @@
 package todoist
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"path"
+	"sync"
 
 	"github.com/google/go-querystring/query"
 )
 
 const (
-	apiBaseUrl string = "https://api.todoist.com/rest"
+	apiBaseUrl  string = "https://api.todoist.com/rest"
+	syncBaseUrl string = "https://api.todoist.com/sync"
 )
 
 // Client for Todoist REST API.
@@ -20,18 +24,86 @@ type Client struct {
 	token string
 
 	restAPI restAPI
+	newUUID func() string
+	// Context of requests, or nil for the background context.
+	ctx context.Context
+
+	assignees     *AssigneeResolver
+	assigneesOnce sync.Once
+}
+
+// Options for creating a client.
+type ClientOptions struct {
+	// Logger used to log every API call.
+	// If not set, nothing is logged.
+	Logger *slog.Logger
+	// Whether to log request payloads and response bodies.
+	// This is intended for debugging and only takes effect when Logger is set.
+	LogPayload bool
+	// Tracer used to start a span for every API operation.
+	Tracer Tracer
+	// Meter used to record request, error and latency metrics.
+	Meter Meter
+	// Maximum number of requests per second.
+	// If not set, requests are not rate limited.
+	RateLimit float64
+	// Options for the circuit breaker.
+	// If not set, the circuit breaker is disabled.
+	CircuitBreaker *CircuitBreakerOptions
+	// Options for caching responses.
+	// If not set, responses are not cached.
+	Cache *CacheOptions
 }
 
 // Returns new client.
 func New(token string) *Client {
+	return NewWithOptions(token, nil)
+}
+
+// Returns new client with options.
+func NewWithOptions(token string, opts *ClientOptions) *Client {
+	if opts == nil {
+		opts = &ClientOptions{}
+	}
+
+	var api restAPI = newRESTClient()
+	if opts.RateLimit > 0 {
+		api = newRateLimitAPI(api, opts.RateLimit)
+	}
+	if opts.CircuitBreaker != nil {
+		api = newCircuitBreakerAPI(api, opts.CircuitBreaker)
+	}
+	if opts.Logger != nil {
+		api = newLoggingAPI(api, opts.Logger, opts.LogPayload)
+	}
+	if opts.Tracer != nil || opts.Meter != nil {
+		api = newTracingAPI(api, opts.Tracer, opts.Meter)
+	}
+	if opts.Cache != nil {
+		api = newCachingAPI(api, opts.Cache)
+	}
+
 	return &Client{
 		token:   token,
-		restAPI: newRESTClient(),
+		restAPI: api,
+		newUUID: newUUID,
+	}
+}
+
+// Returns a copy of the client that makes requests with ctx.
+// The context cancels requests and is passed to the Tracer, so spans are parented to the caller's trace.
+func (cl *Client) WithContext(ctx context.Context) *Client {
+	return &Client{
+		token:     cl.token,
+		restAPI:   cl.restAPI,
+		newUUID:   cl.newUUID,
+		ctx:       ctx,
+		assignees: cl.assigneeResolver(),
 	}
 }
 
 func (cl *Client) get(p string, params interface{}, out interface{}) error {
-	body, err := cl.sendRequest(p, params, http.MethodGet, nil, nil)
+	body, err := cl.sendRequest(apiBaseUrl, p, params, http.MethodGet, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -44,7 +116,7 @@ func (cl *Client) get(p string, params interface{}, out interface{}) error {
 }
 
 func (cl *Client) post(p string, payload map[string]interface{}, reqID *string, out interface{}) error {
-	body, err := cl.sendRequest(p, nil, http.MethodPost, payload, reqID)
+	body, err := cl.sendRequest(apiBaseUrl, p, nil, http.MethodPost, payload, reqID)
 	if err != nil {
 		return err
 	}
@@ -57,7 +129,7 @@ func (cl *Client) post(p string, payload map[string]interface{}, reqID *string,
 }
 
 func (cl *Client) postWithoutBind(p string, payload map[string]interface{}, reqID *string) error {
-	if _, err := cl.sendRequest(p, nil, http.MethodPost, payload, reqID); err != nil {
+	if _, err := cl.sendRequest(apiBaseUrl, p, nil, http.MethodPost, payload, reqID); err != nil {
 		return err
 	}
 
@@ -65,15 +137,15 @@ func (cl *Client) postWithoutBind(p string, payload map[string]interface{}, reqI
 }
 
 func (cl *Client) delete(p string, reqID *string) error {
-	if _, err := cl.sendRequest(p, nil, http.MethodDelete, nil, reqID); err != nil {
+	if _, err := cl.sendRequest(apiBaseUrl, p, nil, http.MethodDelete, nil, reqID); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (cl *Client) buildEndpoint(p string, params interface{}) (string, error) {
-	u, err := url.Parse(apiBaseUrl)
+func (cl *Client) buildEndpoint(base, p string, params interface{}) (string, error) {
+	u, err := url.Parse(base)
 	if err != nil {
 		return "", err
 	}
@@ -102,6 +174,7 @@ func (cl *Client) buildRequest(ep, method string, payload map[string]interface{}
 	}
 
 	return &restRequest{
+		Context: cl.ctx,
 		URL:     ep,
 		Method:  method,
 		Payload: payload,
@@ -109,8 +182,8 @@ func (cl *Client) buildRequest(ep, method string, payload map[string]interface{}
 	}
 }
 
-func (cl *Client) sendRequest(p string, params interface{}, method string, payload map[string]interface{}, reqID *string) (io.Reader, error) {
-	ep, err := cl.buildEndpoint(p, params)
+func (cl *Client) sendRequest(base, p string, params interface{}, method string, payload map[string]interface{}, reqID *string) (io.Reader, error) {
+	ep, err := cl.buildEndpoint(base, p, params)
 	if err != nil {
 		return nil, err
 	}
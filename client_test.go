@@ -1,6 +1,12 @@
 package todoist
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,7 +14,17 @@ import (
 
 func newClientForTest() (*Client, *mockRestAPI) {
 	api := &mockRestAPI{}
-	return &Client{token: "TOKEN", restAPI: api}, api
+	n := 0
+	newUUID := func() string {
+		n++
+		return fmt.Sprintf("UUID_%d", n)
+	}
+	return &Client{token: "TOKEN", restAPI: api, newUUID: newUUID}, api
+}
+
+func withContextForTest(ctx context.Context, req *restRequest) *restRequest {
+	req.Context = ctx
+	return req
 }
 
 func TestNew(t *testing.T) {
@@ -22,3 +38,44 @@ func TestNew(t *testing.T) {
 		assert.Equal(t, tkn, cl.token)
 	})
 }
+
+func TestNewWithOptions(t *testing.T) {
+	t.Run("should return a client", func(t *testing.T) {
+		cl := NewWithOptions("TOKEN", nil)
+
+		assert.NotNil(t, cl)
+		assert.IsType(t, &restClient{}, cl.restAPI)
+		assert.Equal(t, "TOKEN", cl.token)
+	})
+
+	t.Run("should return a client with logger", func(t *testing.T) {
+		cl := NewWithOptions("TOKEN", &ClientOptions{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))})
+
+		assert.NotNil(t, cl)
+		assert.IsType(t, &loggingAPI{}, cl.restAPI)
+		assert.Equal(t, "TOKEN", cl.token)
+	})
+}
+
+func TestClient_WithContext(t *testing.T) {
+	t.Run("should make requests with the context", func(t *testing.T) {
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "VALUE")
+		cl, api := newClientForTest()
+		api.On("Do", &restRequest{
+			Context: ctx,
+			URL:     "https://api.todoist.com/rest/v1/tasks/1",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "id": 1 }`),
+		}, nil)
+
+		task, err := cl.WithContext(ctx).GetTask(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, task.ID)
+		api.AssertExpectations(t)
+	})
+}
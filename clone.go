@@ -0,0 +1,236 @@
+package todoist
+
+import (
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const defaultCloneNameTemplate string = "{{.Name}} (copy)"
+
+// Options for cloning a project.
+type CloneOptions struct {
+	// Template for the name of the new project, rendered with text/template.
+	// The source project is available as the data (e.g. "{{.Name}} - onboarding").
+	// Default is "{{.Name}} (copy)".
+	NameTemplate *string
+	// Whether to copy project and task comments.
+	Comments *bool
+	// Whether to only plan the clone without creating anything.
+	DryRun *bool
+}
+
+// Plan or result of cloning a project.
+type CloneResult struct {
+	// New project.
+	// In dry-run mode, only the name and the attributes to be copied are set.
+	Project *Project
+	// Created sections, or source sections to be copied in dry-run mode, in creation order.
+	Sections Sections
+	// Created tasks, or source tasks to be copied in dry-run mode, in creation order.
+	Tasks Tasks
+	// Created comments, or source comments to be copied in dry-run mode, in creation order.
+	Comments Comments
+	// Mapping from source section IDs to created section IDs.
+	SectionIDs map[int]int
+	// Mapping from source task IDs to created task IDs.
+	TaskIDs map[int]int
+}
+
+// Clones a project with its sections, tasks, subtasks and optionally comments into a new project.
+func (cl *Client) CloneProject(srcID int, opts *CloneOptions) (*CloneResult, error) {
+	if opts == nil {
+		opts = &CloneOptions{}
+	}
+
+	src, err := cl.GetProject(srcID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := renderCloneName(opts.NameTemplate, src)
+	if err != nil {
+		return nil, err
+	}
+
+	secs, err := cl.GetSectionsWithOptions(&GetSectionsOptions{ProjectID: &srcID})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(secs, func(i, j int) bool { return secs[i].Order < secs[j].Order })
+
+	tasks, err := cl.GetTasksWithOptions(&GetTasksOptions{ProjectID: &srcID})
+	if err != nil {
+		return nil, err
+	}
+	tasks = sortTaskTree(tasks)
+
+	var projCmts Comments
+	taskCmts := map[int]Comments{}
+	if opts.Comments != nil && *opts.Comments {
+		projCmts, err = cl.GetProjectComments(srcID)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range tasks {
+			if task.CommentCount == 0 {
+				continue
+			}
+			cmts, err := cl.GetTaskComments(task.ID)
+			if err != nil {
+				return nil, err
+			}
+			taskCmts[task.ID] = cmts
+		}
+	}
+
+	res := &CloneResult{SectionIDs: map[int]int{}, TaskIDs: map[int]int{}}
+
+	if opts.DryRun != nil && *opts.DryRun {
+		res.Project = &Project{Name: name, Color: src.Color, Favorite: src.Favorite, ParentID: src.ParentID}
+		res.Sections = secs
+		res.Tasks = tasks
+		res.Comments = append(res.Comments, projCmts...)
+		for _, task := range tasks {
+			res.Comments = append(res.Comments, taskCmts[task.ID]...)
+		}
+		return res, nil
+	}
+
+	proj, err := cl.CreateProjectWithOptions(name, &CreateProjectOptions{ParentID: src.ParentID, Color: &src.Color, Favorite: &src.Favorite})
+	if err != nil {
+		return nil, err
+	}
+	res.Project = proj
+
+	for _, cmt := range projCmts {
+		created, err := cl.CreateProjectCommentWithOptions(proj.ID, cmt.Content, &CreateProjectCommentOptions{Attachment: cloneAttachment(cmt.Attachment)})
+		if err != nil {
+			return res, err
+		}
+		res.Comments = append(res.Comments, created)
+	}
+
+	for _, sec := range secs {
+		order := sec.Order
+		created, err := cl.CreateSectionWithOptions(sec.Name, proj.ID, &CreateSectionOptions{Order: &order})
+		if err != nil {
+			return res, err
+		}
+		res.Sections = append(res.Sections, created)
+		res.SectionIDs[sec.ID] = created.ID
+	}
+
+	for _, task := range tasks {
+		created, err := cl.CreateTaskWithOptions(task.Content, cloneTaskOptions(task, proj.ID, res))
+		if err != nil {
+			return res, err
+		}
+		res.Tasks = append(res.Tasks, created)
+		res.TaskIDs[task.ID] = created.ID
+
+		for _, cmt := range taskCmts[task.ID] {
+			created, err := cl.CreateTaskCommentWithOptions(created.ID, cmt.Content, &CreateTaskCommentOptions{Attachment: cloneAttachment(cmt.Attachment)})
+			if err != nil {
+				return res, err
+			}
+			res.Comments = append(res.Comments, created)
+		}
+	}
+
+	return res, nil
+}
+
+func renderCloneName(tmpl *string, src *Project) (string, error) {
+	s := defaultCloneNameTemplate
+	if tmpl != nil {
+		s = *tmpl
+	}
+
+	t, err := template.New("name").Parse(s)
+	if err != nil {
+		return "", err
+	}
+	b := new(strings.Builder)
+	if err := t.Execute(b, src); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func cloneTaskOptions(task *Task, projectID int, res *CloneResult) *CreateTaskOptions {
+	order := task.Order
+	priority := task.Priority
+	opts := &CreateTaskOptions{
+		ProjectID: &projectID,
+		Order:     &order,
+		Priority:  &priority,
+	}
+	if task.Description != "" {
+		desc := task.Description
+		opts.Description = &desc
+	}
+	if len(task.LabelIDs) > 0 {
+		labelIDs := append([]int{}, task.LabelIDs...)
+		opts.LabelIDs = &labelIDs
+	}
+	if id, ok := res.SectionIDs[task.SectionID]; ok {
+		opts.SectionID = &id
+	}
+	if task.ParentID != nil {
+		if id, ok := res.TaskIDs[*task.ParentID]; ok {
+			opts.ParentID = &id
+		}
+	}
+	if task.Due != nil && task.Due.String != "" {
+		due := task.Due.String
+		opts.DueString = &due
+	}
+
+	return opts
+}
+
+func cloneAttachment(att *Attachment) *CreateAttachmentOptions {
+	if att == nil {
+		return nil
+	}
+
+	return &CreateAttachmentOptions{
+		ResourceType: &att.ResourceType,
+		FileName:     att.FileName,
+		FileURL:      att.FileURL,
+		FileType:     att.FileType,
+	}
+}
+
+// Returns tasks ordered so that parents come before their subtasks and siblings are sorted by order.
+// Tasks whose parent is not in the list are treated as top-level tasks.
+func sortTaskTree(tasks Tasks) Tasks {
+	ids := map[int]bool{}
+	for _, task := range tasks {
+		ids[task.ID] = true
+	}
+
+	children := map[int]Tasks{}
+	roots := Tasks{}
+	for _, task := range tasks {
+		if task.ParentID != nil && ids[*task.ParentID] {
+			children[*task.ParentID] = append(children[*task.ParentID], task)
+			continue
+		}
+		roots = append(roots, task)
+	}
+
+	sorted := Tasks{}
+	var walk func(ts Tasks)
+	walk = func(ts Tasks) {
+		sort.SliceStable(ts, func(i, j int) bool { return ts[i].Order < ts[j].Order })
+		for _, task := range ts {
+			sorted = append(sorted, task)
+			walk(children[task.ID])
+		}
+	}
+	walk(roots)
+
+	return sorted
+}
@@ -0,0 +1,129 @@
+package todoist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mockCloneSourceForTest(api *mockRestAPI) {
+	get := func(url, body string) {
+		api.On("Do", &restRequest{
+			URL:     url,
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil)
+	}
+
+	get("https://api.todoist.com/rest/v1/projects/1", `{ "id": 1, "name": "ONBOARDING", "color": 30 }`)
+	get("https://api.todoist.com/rest/v1/sections?project_id=1", `[{ "id": 10, "project_id": 1, "order": 1, "name": "SECTION" }]`)
+	get("https://api.todoist.com/rest/v1/tasks?project_id=1", `[
+		{ "id": 101, "project_id": 1, "section_id": 10, "parent_id": 100, "order": 1, "content": "SUBTASK", "priority": 1 },
+		{ "id": 100, "project_id": 1, "section_id": 10, "order": 1, "content": "TASK", "description": "DESCRIPTION", "priority": 4, "label_ids": [5], "due": { "string": "every day" }, "comment_count": 1 }
+	]`)
+}
+
+func TestClient_CloneProject(t *testing.T) {
+	t.Run("should return a plan in dry-run mode", func(t *testing.T) {
+		cl, api := newClientForTest()
+		mockCloneSourceForTest(api)
+		api.On("Do", &restRequest{
+			URL:     "https://api.todoist.com/rest/v1/comments?project_id=1",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[]`)}, nil)
+		api.On("Do", &restRequest{
+			URL:     "https://api.todoist.com/rest/v1/comments?task_id=100",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 1000, "content": "COMMENT" }]`)}, nil)
+
+		res, err := cl.CloneProject(1, &CloneOptions{
+			NameTemplate: String("{{.Name}} - NEW HIRE"),
+			Comments:     Bool(true),
+			DryRun:       Bool(true),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Project{Name: "ONBOARDING - NEW HIRE", Color: 30}, res.Project)
+		assert.Len(t, res.Sections, 1)
+		if assert.Len(t, res.Tasks, 2) {
+			assert.Equal(t, 100, res.Tasks[0].ID)
+			assert.Equal(t, 101, res.Tasks[1].ID)
+		}
+		assert.Equal(t, Comments{{ID: 1000, Content: "COMMENT"}}, res.Comments)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should clone a project", func(t *testing.T) {
+		cl, api := newClientForTest()
+		mockCloneSourceForTest(api)
+		post := func(url string, payload map[string]interface{}, body string) {
+			api.On("Do", &restRequest{
+				URL:     url,
+				Method:  http.MethodPost,
+				Payload: payload,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil).Once()
+		}
+
+		post("https://api.todoist.com/rest/v1/projects",
+			map[string]interface{}{"name": "ONBOARDING (copy)", "color": Int(30), "favorite": Bool(false)},
+			`{ "id": 2, "name": "ONBOARDING (copy)" }`)
+		post("https://api.todoist.com/rest/v1/sections",
+			map[string]interface{}{"name": "SECTION", "project_id": 2, "order": Int(1)},
+			`{ "id": 20, "project_id": 2, "name": "SECTION" }`)
+		post("https://api.todoist.com/rest/v1/tasks",
+			map[string]interface{}{
+				"content":     "TASK",
+				"description": String("DESCRIPTION"),
+				"project_id":  Int(2),
+				"section_id":  Int(20),
+				"order":       Int(1),
+				"label_ids":   Ints(5),
+				"priority":    Int(4),
+				"due_string":  String("every day"),
+			},
+			`{ "id": 200, "content": "TASK" }`)
+		post("https://api.todoist.com/rest/v1/tasks",
+			map[string]interface{}{
+				"content":    "SUBTASK",
+				"project_id": Int(2),
+				"section_id": Int(20),
+				"parent_id":  Int(200),
+				"order":      Int(1),
+				"priority":   Int(1),
+			},
+			`{ "id": 201, "content": "SUBTASK" }`)
+
+		res, err := cl.CloneProject(1, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Project.ID)
+		assert.Equal(t, map[int]int{10: 20}, res.SectionIDs)
+		assert.Equal(t, map[int]int{100: 200, 101: 201}, res.TaskIDs)
+		assert.Len(t, res.Tasks, 2)
+		api.AssertExpectations(t)
+	})
+}
+
+func Test_sortTaskTree(t *testing.T) {
+	t.Run("should order parents before subtasks", func(t *testing.T) {
+		tasks := Tasks{
+			{ID: 3, ParentID: Int(1), Order: 2},
+			{ID: 2, ParentID: Int(1), Order: 1},
+			{ID: 4, Order: 2},
+			{ID: 1, Order: 1},
+			{ID: 5, ParentID: Int(99), Order: 3},
+		}
+
+		ids := []int{}
+		for _, task := range sortTaskTree(tasks) {
+			ids = append(ids, task.ID)
+		}
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	})
+}
@@ -0,0 +1,157 @@
+package todoist
+
+import (
+	"sort"
+	"time"
+)
+
+// State of a collaborator in a shared project.
+type CollaboratorState string
+
+const (
+	CollaboratorActive  CollaboratorState = "active"
+	CollaboratorInvited CollaboratorState = "invited"
+	CollaboratorDeleted CollaboratorState = "deleted"
+)
+
+// Invitation to a shared project.
+type Invitation struct {
+	// Invitation ID.
+	ID int `json:"id"`
+	// Secret required to accept or reject the invitation.
+	Secret string `json:"secret"`
+	// Name of the shared project.
+	ProjectName string `json:"project_name"`
+	// User who sent the invitation.
+	From *User `json:"from"`
+	// Date and time when the invitation was sent.
+	Created time.Time `json:"created"`
+}
+
+// List of invitations.
+type Invitations []*Invitation
+
+// Shares a project with a user by email.
+// An invitation is sent if the user is not a collaborator yet.
+func (cl *Client) ShareProject(projectID int, email string) error {
+	if _, err := cl.syncCommands(cl.newCommand("share_project", map[string]interface{}{"project_id": projectID, "email": email})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Removes a user from a shared project by email.
+func (cl *Client) UnshareProject(projectID int, email string) error {
+	if _, err := cl.syncCommands(cl.newCommand("delete_collaborator", map[string]interface{}{"project_id": projectID, "email": email})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Gets list of all users of a shared project including invited and removed users, with their state.
+func (cl *Client) GetCollaboratorStates(projectID int) (Users, error) {
+	out := struct {
+		Collaborators      []*syncCollaborator      `json:"collaborators"`
+		CollaboratorStates []*syncCollaboratorState `json:"collaborator_states"`
+	}{}
+	if _, err := cl.syncRead("*", []string{"collaborators"}, &out); err != nil {
+		return nil, err
+	}
+
+	collabs := map[int]*syncCollaborator{}
+	for _, c := range out.Collaborators {
+		collabs[c.ID] = c
+	}
+	projectIDs := map[int][]int{}
+	for _, s := range out.CollaboratorStates {
+		if s.State == CollaboratorActive {
+			projectIDs[s.UserID] = append(projectIDs[s.UserID], s.ProjectID)
+		}
+	}
+
+	users := Users{}
+	for _, s := range out.CollaboratorStates {
+		if s.ProjectID != projectID {
+			continue
+		}
+		user := &User{ID: s.UserID, State: s.State, ProjectIDs: projectIDs[s.UserID]}
+		if c, ok := collabs[s.UserID]; ok {
+			user.Name = c.FullName
+			user.Email = c.Email
+		}
+		sort.Ints(user.ProjectIDs)
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// Gets list of pending invitations to shared projects.
+func (cl *Client) GetInvitations() (Invitations, error) {
+	out := struct {
+		LiveNotifications []*syncInvitationNotification `json:"live_notifications"`
+	}{}
+	if _, err := cl.syncRead("*", []string{"live_notifications"}, &out); err != nil {
+		return nil, err
+	}
+
+	invs := Invitations{}
+	for _, n := range out.LiveNotifications {
+		if n.NotificationType != "share_invitation_sent" || n.State != "invited" || n.IsDeleted {
+			continue
+		}
+		inv := &Invitation{ID: n.InvitationID, Secret: n.InvitationSecret, ProjectName: n.ProjectName, Created: time.Unix(n.Created, 0).UTC()}
+		if n.FromUser != nil {
+			inv.From = &User{ID: n.FromUser.ID, Name: n.FromUser.FullName, Email: n.FromUser.Email}
+		}
+		invs = append(invs, inv)
+	}
+
+	return invs, nil
+}
+
+// Accepts an invitation to a shared project.
+func (cl *Client) AcceptInvitation(id int, secret string) error {
+	if _, err := cl.syncCommands(cl.newCommand("accept_invitation", map[string]interface{}{"invitation_id": id, "invitation_secret": secret})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Rejects an invitation to a shared project.
+func (cl *Client) RejectInvitation(id int, secret string) error {
+	if _, err := cl.syncCommands(cl.newCommand("reject_invitation", map[string]interface{}{"invitation_id": id, "invitation_secret": secret})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Collaborator object of the Sync API.
+type syncCollaborator struct {
+	ID       int    `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"full_name"`
+}
+
+// Collaborator state object of the Sync API.
+type syncCollaboratorState struct {
+	ProjectID int               `json:"project_id"`
+	UserID    int               `json:"user_id"`
+	State     CollaboratorState `json:"state"`
+}
+
+// Live notification object of the Sync API for a project invitation.
+type syncInvitationNotification struct {
+	NotificationType string            `json:"notification_type"`
+	Created          int64             `json:"created"`
+	FromUser         *syncCollaborator `json:"from_user"`
+	InvitationID     int               `json:"invitation_id"`
+	InvitationSecret string            `json:"invitation_secret"`
+	ProjectName      string            `json:"project_name"`
+	State            string            `json:"state"`
+	IsDeleted        intBool           `json:"is_deleted"`
+}
@@ -0,0 +1,178 @@
+package todoist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ShareProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should share a project",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the command fails",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": { "error_code": 33, "error": "Project not found", "http_code": 404 } }, "temp_id_mapping": {} }`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			cmd := &syncCommand{Type: "share_project", UUID: "UUID_1", Args: map[string]interface{}{"project_id": 1, "email": "new@example.com"}}
+			api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(tt.resp, nil)
+
+			err := cl.ShareProject(1, "new@example.com")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, SyncError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_UnshareProject(t *testing.T) {
+	cl, api := newClientForTest()
+	cmd := &syncCommand{Type: "delete_collaborator", UUID: "UUID_1", Args: map[string]interface{}{"project_id": 1, "email": "old@example.com"}}
+	api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+	}, nil)
+
+	err := cl.UnshareProject(1, "old@example.com")
+
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
+
+func TestClient_GetCollaboratorStates(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		want    Users
+		wantErr bool
+	}{
+		{
+			name: "should return users of the project with their state",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body: strings.NewReader(`{
+					"collaborators": [
+						{ "id": 100, "email": "a@example.com", "full_name": "A" },
+						{ "id": 200, "email": "b@example.com", "full_name": "B" },
+						{ "id": 300, "email": "c@example.com", "full_name": "C" }
+					],
+					"collaborator_states": [
+						{ "project_id": 2, "user_id": 100, "state": "active" },
+						{ "project_id": 1, "user_id": 100, "state": "active" },
+						{ "project_id": 1, "user_id": 200, "state": "invited" },
+						{ "project_id": 1, "user_id": 300, "state": "deleted" },
+						{ "project_id": 2, "user_id": 300, "state": "active" }
+					]
+				}`),
+			},
+			want: Users{
+				{ID: 100, Name: "A", Email: "a@example.com", State: CollaboratorActive, ProjectIDs: []int{1, 2}},
+				{ID: 200, Name: "B", Email: "b@example.com", State: CollaboratorInvited},
+				{ID: 300, Name: "C", Email: "c@example.com", State: CollaboratorDeleted, ProjectIDs: []int{2}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", newSyncRequestForTest(map[string]interface{}{"sync_token": "*", "resource_types": []string{"collaborators"}})).Return(tt.resp, nil)
+
+			users, err := cl.GetCollaboratorStates(1)
+
+			assert.Equal(t, tt.want, users)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_GetInvitations(t *testing.T) {
+	cl, api := newClientForTest()
+	api.On("Do", newSyncRequestForTest(map[string]interface{}{"sync_token": "*", "resource_types": []string{"live_notifications"}})).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body: strings.NewReader(`{
+			"live_notifications": [
+				{ "id": 1, "notification_type": "share_invitation_sent", "created": 1760000000, "from_user": { "id": 100, "email": "a@example.com", "full_name": "A" }, "invitation_id": 10, "invitation_secret": "SECRET", "project_name": "PROJECT", "state": "invited", "is_deleted": 0 },
+				{ "id": 2, "notification_type": "share_invitation_sent", "created": 1760000000, "invitation_id": 11, "invitation_secret": "SECRET", "project_name": "PROJECT", "state": "accepted", "is_deleted": 0 },
+				{ "id": 3, "notification_type": "item_assigned", "created": 1760000000, "is_deleted": 0 }
+			]
+		}`),
+	}, nil)
+
+	invs, err := cl.GetInvitations()
+
+	assert.Equal(t, Invitations{
+		{ID: 10, Secret: "SECRET", ProjectName: "PROJECT", From: &User{ID: 100, Name: "A", Email: "a@example.com"}, Created: time.Unix(1760000000, 0).UTC()},
+	}, invs)
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
+
+func TestClient_AcceptInvitation(t *testing.T) {
+	cl, api := newClientForTest()
+	cmd := &syncCommand{Type: "accept_invitation", UUID: "UUID_1", Args: map[string]interface{}{"invitation_id": 10, "invitation_secret": "SECRET"}}
+	api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+	}, nil)
+
+	err := cl.AcceptInvitation(10, "SECRET")
+
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
+
+func TestClient_RejectInvitation(t *testing.T) {
+	cl, api := newClientForTest()
+	cmd := &syncCommand{Type: "reject_invitation", UUID: "UUID_1", Args: map[string]interface{}{"invitation_id": 10, "invitation_secret": "SECRET"}}
+	api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+	}, nil)
+
+	err := cl.RejectInvitation(10, "SECRET")
+
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
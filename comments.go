@@ -212,3 +212,30 @@ func (cl *Client) DeleteCommentWithOptions(id int, opts *DeleteCommentOptions) e
 
 	return nil
 }
+
+// Note (task comment) or project note object of the Sync API.
+type syncNote struct {
+	ID             int         `json:"id"`
+	ItemID         *int        `json:"item_id"`
+	ProjectID      *int        `json:"project_id"`
+	Posted         string      `json:"posted"`
+	Content        string      `json:"content"`
+	FileAttachment *Attachment `json:"file_attachment"`
+	IsDeleted      intBool     `json:"is_deleted"`
+}
+
+func (snote *syncNote) comment() *Comment {
+	cmt := &Comment{
+		ID:         snote.ID,
+		TaskID:     snote.ItemID,
+		Posted:     snote.Posted,
+		Content:    snote.Content,
+		Attachment: snote.FileAttachment,
+	}
+	// notes of tasks also have the project ID, which REST API does not return.
+	if snote.ItemID == nil {
+		cmt.ProjectID = snote.ProjectID
+	}
+
+	return cmt
+}
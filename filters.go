@@ -0,0 +1,179 @@
+package todoist
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+type Filter struct {
+	// Filter ID.
+	ID int `json:"id"`
+	// Filter name.
+	Name string `json:"name"`
+	// Filter query (https://todoist.com/help/articles/205248842).
+	Query string `json:"query"`
+	// A numeric ID representing the color of the filter icon.
+	// Refer to the id column in the Colors guide (https://developer.todoist.com/guides/#colors) for more info.
+	Color int `json:"color"`
+	// Filter position in the filter list.
+	Order int `json:"order"`
+	// Whether the filter is a favorite (a true or false value).
+	Favorite bool `json:"favorite"`
+}
+
+// List of filters.
+type Filters []*Filter
+
+// Gets list of all filters in the order of the filter list.
+func (cl *Client) GetFilters() (Filters, error) {
+	out := struct {
+		Filters []*syncFilter `json:"filters"`
+	}{}
+	if _, err := cl.syncRead("*", []string{"filters"}, &out); err != nil {
+		return nil, err
+	}
+
+	fs := Filters{}
+	for _, sf := range out.Filters {
+		if sf.IsDeleted {
+			continue
+		}
+		fs = append(fs, sf.filter())
+	}
+	sort.SliceStable(fs, func(i, j int) bool { return fs[i].Order < fs[j].Order })
+
+	return fs, nil
+}
+
+// Gets a filter.
+func (cl *Client) GetFilter(id int) (*Filter, error) {
+	fs, err := cl.GetFilters()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range fs {
+		if f.ID == id {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("filter not found: %d", id)
+}
+
+// Gets active tasks matching the query of a filter.
+func (cl *Client) GetTasksForFilter(filterID int) (Tasks, error) {
+	f, err := cl.GetFilter(filterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetTasksWithOptions(&GetTasksOptions{Filter: &f.Query})
+}
+
+// Options for creating a filter.
+type CreateFilterOptions struct {
+	// A numeric ID representing the color of the filter icon.
+	// Refer to the id column in the Colors guide (https://developer.todoist.com/guides/#colors) for more info.
+	Color *int `json:"color,omitempty"`
+	// Filter position in the filter list.
+	Order *int `json:"item_order,omitempty"`
+	// Whether the filter is a favorite (a true or false value).
+	Favorite *bool `json:"is_favorite,omitempty"`
+}
+
+// Creates a new filter and returns it.
+func (cl *Client) CreateFilter(name, query string) (*Filter, error) {
+	return cl.CreateFilterWithOptions(name, query, nil)
+}
+
+// Creates a new filter with options and returns it.
+func (cl *Client) CreateFilterWithOptions(name, query string, opts *CreateFilterOptions) (*Filter, error) {
+	args := map[string]interface{}{"name": name, "query": query}
+	if err := toMap(opts, args); err != nil {
+		return nil, err
+	}
+
+	cmd := cl.newCreateCommand("filter_add", args)
+	resp, err := cl.syncCommands(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetFilter(resp.TempIDMapping[cmd.TempID])
+}
+
+// Options for updating a filter.
+type UpdateFilterOptions struct {
+	// Filter name.
+	Name *string `json:"name,omitempty"`
+	// Filter query (https://todoist.com/help/articles/205248842).
+	Query *string `json:"query,omitempty"`
+	// A numeric ID representing the color of the filter icon.
+	// Refer to the id column in the Colors guide (https://developer.todoist.com/guides/#colors) for more info.
+	Color *int `json:"color,omitempty"`
+	// Filter position in the filter list.
+	Order *int `json:"item_order,omitempty"`
+	// Whether the filter is a favorite (a true or false value).
+	Favorite *bool `json:"is_favorite,omitempty"`
+}
+
+// Updates a filter.
+func (cl *Client) UpdateFilter(id int, opts *UpdateFilterOptions) error {
+	args := map[string]interface{}{"id": id}
+	if err := toMap(opts, args); err != nil {
+		return err
+	}
+
+	if _, err := cl.syncCommands(cl.newCommand("filter_update", args)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Deletes a filter.
+func (cl *Client) DeleteFilter(id int) error {
+	if _, err := cl.syncCommands(cl.newCommand("filter_delete", map[string]interface{}{"id": id})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reorders filters in the order of IDs.
+func (cl *Client) ReorderFilters(ids []int) error {
+	orders := map[string]int{}
+	for i, id := range ids {
+		orders[strconv.Itoa(id)] = i + 1
+	}
+
+	if _, err := cl.syncCommands(cl.newCommand("filter_update_orders", map[string]interface{}{"id_order_mapping": orders})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Filter object of the Sync API.
+type syncFilter struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Query      string  `json:"query"`
+	Color      int     `json:"color"`
+	ItemOrder  int     `json:"item_order"`
+	IsFavorite intBool `json:"is_favorite"`
+	IsDeleted  intBool `json:"is_deleted"`
+}
+
+func (sf *syncFilter) filter() *Filter {
+	return &Filter{
+		ID:       sf.ID,
+		Name:     sf.Name,
+		Query:    sf.Query,
+		Color:    sf.Color,
+		Order:    sf.ItemOrder,
+		Favorite: bool(sf.IsFavorite),
+	}
+}
@@ -0,0 +1,195 @@
+package todoist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const filtersResponseForTest = `{
+	"sync_token": "TOKEN",
+	"filters": [
+		{ "id": 2, "name": "WAITING", "query": "@waiting", "color": 30, "item_order": 2, "is_favorite": 0, "is_deleted": 0 },
+		{ "id": 1, "name": "BLOCKED", "query": "today", "color": 31, "item_order": 1, "is_favorite": 1, "is_deleted": 0 },
+		{ "id": 3, "name": "DELETED", "query": "p1", "color": 32, "item_order": 3, "is_favorite": 0, "is_deleted": 1 }
+	]
+}`
+
+func newFiltersRequestForTest() *restRequest {
+	return newSyncRequestForTest(map[string]interface{}{"sync_token": "*", "resource_types": []string{"filters"}})
+}
+
+func TestClient_GetFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		want    Filters
+		wantErr bool
+	}{
+		{
+			name: "should return filters in order",
+			resp: &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(filtersResponseForTest)},
+			want: Filters{
+				{ID: 1, Name: "BLOCKED", Query: "today", Color: 31, Order: 1, Favorite: true},
+				{ID: 2, Name: "WAITING", Query: "@waiting", Color: 30, Order: 2},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error if the request fails",
+			resp:    &restResponse{StatusCode: http.StatusBadRequest, Body: strings.NewReader("ERROR_RESPONSE")},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", newFiltersRequestForTest()).Return(tt.resp, nil)
+
+			fs, err := cl.GetFilters()
+
+			assert.Equal(t, tt.want, fs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_GetTasksForFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filterID int
+		want     Tasks
+		wantErr  bool
+	}{
+		{
+			name:     "should return tasks matching the filter query",
+			filterID: 1,
+			want:     Tasks{{ID: 1, Content: "TASK"}},
+			wantErr:  false,
+		},
+		{
+			name:     "should return an error if the filter does not exist",
+			filterID: 3,
+			want:     nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", newFiltersRequestForTest()).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(filtersResponseForTest)}, nil)
+			if !tt.wantErr {
+				api.On("Do", &restRequest{
+					URL:     "https://api.todoist.com/rest/v1/tasks?filter=today",
+					Method:  http.MethodGet,
+					Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+				}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`[{ "id": 1, "content": "TASK" }]`)}, nil)
+			}
+
+			tasks, err := cl.GetTasksForFilter(tt.filterID)
+
+			assert.Equal(t, tt.want, tasks)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_CreateFilterWithOptions(t *testing.T) {
+	cl, api := newClientForTest()
+	cmd := &syncCommand{Type: "filter_add", UUID: "UUID_1", TempID: "UUID_2", Args: map[string]interface{}{"name": "BLOCKED", "query": "today", "color": Int(31), "is_favorite": Bool(true)}}
+	api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": { "UUID_2": 1 } }`),
+	}, nil)
+	api.On("Do", newFiltersRequestForTest()).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(filtersResponseForTest)}, nil)
+
+	f, err := cl.CreateFilterWithOptions("BLOCKED", "today", &CreateFilterOptions{Color: Int(31), Favorite: Bool(true)})
+
+	assert.Equal(t, &Filter{ID: 1, Name: "BLOCKED", Query: "today", Color: 31, Order: 1, Favorite: true}, f)
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
+
+func TestClient_UpdateFilter(t *testing.T) {
+	cl, api := newClientForTest()
+	cmd := &syncCommand{Type: "filter_update", UUID: "UUID_1", Args: map[string]interface{}{"id": 1, "name": String("NAME"), "query": String("p1")}}
+	api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+	}, nil)
+
+	err := cl.UpdateFilter(1, &UpdateFilterOptions{Name: String("NAME"), Query: String("p1")})
+
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
+
+func TestClient_DeleteFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should delete a filter",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the command fails",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": { "error_code": 20, "error": "Filter not found", "http_code": 404 } }, "temp_id_mapping": {} }`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			cmd := &syncCommand{Type: "filter_delete", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}}
+			api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(tt.resp, nil)
+
+			err := cl.DeleteFilter(1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, SyncError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_ReorderFilters(t *testing.T) {
+	cl, api := newClientForTest()
+	cmd := &syncCommand{Type: "filter_update_orders", UUID: "UUID_1", Args: map[string]interface{}{"id_order_mapping": map[string]int{"2": 1, "1": 2}}}
+	api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+	}, nil)
+
+	err := cl.ReorderFilters([]int{2, 1})
+
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
@@ -1,6 +1,6 @@
 module github.com/koki-develop/todoist-go
 
-go 1.19
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.1.0
@@ -0,0 +1,77 @@
+package ics
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"github.com/koki-develop/todoist-go"
+)
+
+// Interface to get tasks, satisfied by *todoist.Client.
+type TasksGetter interface {
+	GetTasksWithOptions(opts *todoist.GetTasksOptions) (todoist.Tasks, error)
+}
+
+// Options for the feed handler.
+type HandlerOptions struct {
+	// Options for encoding tasks.
+	Options
+	// Filter tasks by project ID.
+	// It can be overridden by the project_id query parameter if AllowQueryOverride is set.
+	ProjectID *int
+	// Filter tasks by any supported filter (https://todoist.com/help/articles/205248842).
+	// It can be overridden by the filter query parameter if AllowQueryOverride is set.
+	Filter *string
+	// Whether the project_id and filter query parameters override ProjectID and Filter.
+	// Anyone with the feed URL can then read any task of the account, so it is disabled by default.
+	AllowQueryOverride bool
+}
+
+type handler struct {
+	tasks TasksGetter
+	opts  HandlerOptions
+}
+
+// Returns a handler serving a live iCalendar feed of tasks.
+func NewHandler(tasks TasksGetter, opts *HandlerOptions) http.Handler {
+	if opts == nil {
+		opts = &HandlerOptions{}
+	}
+	return &handler{tasks: tasks, opts: *opts}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	gopts := &todoist.GetTasksOptions{ProjectID: h.opts.ProjectID, Filter: h.opts.Filter}
+
+	if h.opts.AllowQueryOverride {
+		q := r.URL.Query()
+		if s := q.Get("project_id"); s != "" {
+			id, err := strconv.Atoi(s)
+			if err != nil {
+				http.Error(w, "invalid project_id", http.StatusBadRequest)
+				return
+			}
+			gopts.ProjectID = &id
+		}
+		if s := q.Get("filter"); s != "" {
+			gopts.Filter = &s
+		}
+	}
+
+	tasks, err := h.tasks.GetTasksWithOptions(gopts)
+	if err != nil {
+		http.Error(w, "failed to get tasks", http.StatusBadGateway)
+		return
+	}
+
+	// encode to a buffer so that an error can still be returned as a response.
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, tasks, &h.opts.Options); err != nil {
+		http.Error(w, "failed to encode tasks", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	_, _ = buf.WriteTo(w)
+}
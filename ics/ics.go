@@ -0,0 +1,263 @@
+// Package ics exports Todoist tasks as iCalendar (RFC 5545).
+package ics
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/koki-develop/todoist-go"
+)
+
+// Type of calendar components.
+type Component string
+
+const (
+	// Event component.
+	ComponentEvent Component = "VEVENT"
+	// To-do component.
+	ComponentTodo Component = "VTODO"
+)
+
+const (
+	defaultProdID   string        = "-//koki-develop//todoist-go//EN"
+	defaultDuration time.Duration = 30 * time.Minute
+	maxLineLength   int           = 75
+)
+
+// Options for encoding tasks.
+type Options struct {
+	// Type of calendar components.
+	// Default is ComponentEvent.
+	Component Component
+	// Calendar name (X-WR-CALNAME).
+	Name string
+	// Duration of events for tasks with exact due time.
+	// Default is 30 minutes.
+	Duration time.Duration
+	// Function returning the current time, used for DTSTAMP.
+	// Default is time.Now.
+	Now func() time.Time
+}
+
+// Writes tasks with a due date as an iCalendar.
+// Tasks without due date are skipped.
+// If the due time of a task can't be parsed, it is written as an all-day task, or skipped if the due date can't be parsed either.
+func Encode(w io.Writer, tasks todoist.Tasks, opts *Options) error {
+	if opts == nil {
+		opts = &Options{}
+	}
+	comp := opts.Component
+	if comp == "" {
+		comp = ComponentEvent
+	}
+	dur := opts.Duration
+	if dur == 0 {
+		dur = defaultDuration
+	}
+	now := time.Now
+	if opts.Now != nil {
+		now = opts.Now
+	}
+	stamp := now().UTC().Format("20060102T150405Z")
+
+	lw := &lineWriter{w: w}
+	lw.line("BEGIN:VCALENDAR")
+	lw.line("VERSION:2.0")
+	lw.line("PRODID:" + defaultProdID)
+	lw.line("CALSCALE:GREGORIAN")
+	if opts.Name != "" {
+		lw.line("X-WR-CALNAME:" + escapeText(opts.Name))
+	}
+
+	for _, task := range tasks {
+		if task.Due == nil {
+			continue
+		}
+
+		start, allDay, ok := dueTime(task.Due)
+		if !ok {
+			continue
+		}
+
+		lw.line("BEGIN:" + string(comp))
+		lw.line(fmt.Sprintf("UID:task-%d@todoist.com", task.ID))
+		lw.line("DTSTAMP:" + stamp)
+		lw.line("SUMMARY:" + escapeText(task.Content))
+		if task.Description != "" {
+			lw.line("DESCRIPTION:" + escapeText(task.Description))
+		}
+		if task.URL != "" {
+			lw.line("URL:" + task.URL)
+		}
+		if p := priority(task.Priority); p > 0 {
+			lw.line("PRIORITY:" + strconv.Itoa(p))
+		}
+
+		if allDay {
+			date := start.Format("20060102")
+			if comp == ComponentTodo {
+				lw.line("DUE;VALUE=DATE:" + date)
+			} else {
+				lw.line("DTSTART;VALUE=DATE:" + date)
+				lw.line("DTEND;VALUE=DATE:" + start.AddDate(0, 0, 1).Format("20060102"))
+			}
+		} else {
+			if comp == ComponentTodo {
+				lw.line("DUE:" + start.UTC().Format("20060102T150405Z"))
+			} else {
+				lw.line("DTSTART:" + start.UTC().Format("20060102T150405Z"))
+				lw.line("DTEND:" + start.Add(dur).UTC().Format("20060102T150405Z"))
+			}
+		}
+
+		if task.Due.Recurring {
+			if rule := RRule(task.Due.String); rule != "" {
+				lw.line("RRULE:" + rule)
+			}
+		}
+		lw.line("END:" + string(comp))
+	}
+
+	lw.line("END:VCALENDAR")
+	return lw.err
+}
+
+// Returns the due time and whether it is all-day, or false if neither the due time nor the due date can be parsed.
+func dueTime(due *todoist.Due) (time.Time, bool, bool) {
+	if due.Datetime != nil {
+		if t, err := time.Parse(time.RFC3339, *due.Datetime); err == nil {
+			return t, false, true
+		}
+	}
+
+	t, err := time.Parse("2006-01-02", due.Date)
+	if err != nil {
+		return time.Time{}, false, false
+	}
+	return t, true, true
+}
+
+// Converts Todoist priority (4 is urgent) to iCalendar priority (1 is highest).
+func priority(p int) int {
+	switch p {
+	case 4:
+		return 1
+	case 3:
+		return 3
+	case 2:
+		return 5
+	default:
+		return 0
+	}
+}
+
+var (
+	everyIntervalRe = regexp.MustCompile(`^every (\d+|other) (day|week|month|year)s?$`)
+	everyUnitRe     = regexp.MustCompile(`^(?:every )?(day|week|month|year)$`)
+	weekdays        = map[string]string{
+		"mon": "MO", "monday": "MO",
+		"tue": "TU", "tues": "TU", "tuesday": "TU",
+		"wed": "WE", "wednesday": "WE",
+		"thu": "TH", "thur": "TH", "thurs": "TH", "thursday": "TH",
+		"fri": "FR", "friday": "FR",
+		"sat": "SA", "saturday": "SA",
+		"sun": "SU", "sunday": "SU",
+	}
+	frequencies = map[string]string{"day": "DAILY", "week": "WEEKLY", "month": "MONTHLY", "year": "YEARLY"}
+)
+
+// Returns an approximate RRULE for a Todoist recurring due string (e.g. "every 2 weeks", "every mon, fri").
+// Returns an empty string if the due string is not supported.
+func RRule(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	// drop time and starting date parts (e.g. "every day at 9am", "every week starting jan 1").
+	for _, sep := range []string{" at ", " starting ", " from ", " until ", " ending "} {
+		if i := strings.Index(s, sep); i >= 0 {
+			s = s[:i]
+		}
+	}
+	// "every!" recurs from the completion date, which can't be represented.
+	s = strings.Replace(s, "every!", "every", 1)
+
+	switch s {
+	case "daily", "every day":
+		return "FREQ=DAILY"
+	case "weekly":
+		return "FREQ=WEEKLY"
+	case "monthly":
+		return "FREQ=MONTHLY"
+	case "yearly", "annually", "every year":
+		return "FREQ=YEARLY"
+	case "every weekday", "every workday":
+		return "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"
+	case "every weekend":
+		return "FREQ=WEEKLY;BYDAY=SA,SU"
+	}
+
+	if m := everyUnitRe.FindStringSubmatch(s); m != nil {
+		return "FREQ=" + frequencies[m[1]]
+	}
+	if m := everyIntervalRe.FindStringSubmatch(s); m != nil {
+		n := m[1]
+		if n == "other" {
+			n = "2"
+		}
+		return fmt.Sprintf("FREQ=%s;INTERVAL=%s", frequencies[m[2]], n)
+	}
+
+	if rest := strings.TrimPrefix(s, "every "); rest != s {
+		days := []string{}
+		for _, d := range strings.FieldsFunc(rest, func(r rune) bool { return r == ',' || r == ' ' }) {
+			if d == "and" {
+				continue
+			}
+			wd, ok := weekdays[d]
+			if !ok {
+				return ""
+			}
+			days = append(days, wd)
+		}
+		if len(days) > 0 {
+			return "FREQ=WEEKLY;BYDAY=" + strings.Join(days, ",")
+		}
+	}
+
+	return ""
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// Writes content lines folded at 75 octets with CRLF line endings.
+type lineWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (lw *lineWriter) line(s string) {
+	if lw.err != nil {
+		return
+	}
+
+	b := new(strings.Builder)
+	n := 0
+	for _, r := range s {
+		l := len(string(r))
+		if n+l > maxLineLength {
+			b.WriteString("\r\n ")
+			n = 1
+		}
+		b.WriteRune(r)
+		n += l
+	}
+	b.WriteString("\r\n")
+
+	_, lw.err = io.WriteString(lw.w, b.String())
+}
@@ -0,0 +1,189 @@
+package ics
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/koki-develop/todoist-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = func() time.Time { return time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+func TestEncode(t *testing.T) {
+	tasks := todoist.Tasks{
+		{ID: 1, Content: "NO DUE"},
+		{ID: 2, Content: "ALL DAY, TASK", Description: "LINE1\nLINE2", URL: "https://todoist.com/showTask?id=2", Priority: 4, Due: &todoist.Due{String: "every 2 weeks", Date: "2022-01-05", Recurring: true}},
+		{ID: 3, Content: "TIMED", Priority: 1, Due: &todoist.Due{String: "jan 6 9am", Date: "2022-01-06", Datetime: todoist.String("2022-01-06T09:00:00Z")}},
+	}
+
+	tests := []struct {
+		name string
+		opts *Options
+		want []string
+	}{
+		{
+			name: "should encode events",
+			opts: &Options{Name: "TEAM", Now: testNow},
+			want: []string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"PRODID:-//koki-develop//todoist-go//EN",
+				"CALSCALE:GREGORIAN",
+				"X-WR-CALNAME:TEAM",
+				"BEGIN:VEVENT",
+				"UID:task-2@todoist.com",
+				"DTSTAMP:20220101T000000Z",
+				`SUMMARY:ALL DAY\, TASK`,
+				`DESCRIPTION:LINE1\nLINE2`,
+				"URL:https://todoist.com/showTask?id=2",
+				"PRIORITY:1",
+				"DTSTART;VALUE=DATE:20220105",
+				"DTEND;VALUE=DATE:20220106",
+				"RRULE:FREQ=WEEKLY;INTERVAL=2",
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"UID:task-3@todoist.com",
+				"DTSTAMP:20220101T000000Z",
+				"SUMMARY:TIMED",
+				"DTSTART:20220106T090000Z",
+				"DTEND:20220106T093000Z",
+				"END:VEVENT",
+				"END:VCALENDAR",
+				"",
+			},
+		},
+		{
+			name: "should encode to-dos",
+			opts: &Options{Component: ComponentTodo, Now: testNow},
+			want: []string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"PRODID:-//koki-develop//todoist-go//EN",
+				"CALSCALE:GREGORIAN",
+				"BEGIN:VTODO",
+				"UID:task-2@todoist.com",
+				"DTSTAMP:20220101T000000Z",
+				`SUMMARY:ALL DAY\, TASK`,
+				`DESCRIPTION:LINE1\nLINE2`,
+				"URL:https://todoist.com/showTask?id=2",
+				"PRIORITY:1",
+				"DUE;VALUE=DATE:20220105",
+				"RRULE:FREQ=WEEKLY;INTERVAL=2",
+				"END:VTODO",
+				"BEGIN:VTODO",
+				"UID:task-3@todoist.com",
+				"DTSTAMP:20220101T000000Z",
+				"SUMMARY:TIMED",
+				"DUE:20220106T090000Z",
+				"END:VTODO",
+				"END:VCALENDAR",
+				"",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := Encode(buf, tasks, tt.opts)
+
+			assert.NoError(t, err)
+			assert.Equal(t, strings.Join(tt.want, "\r\n"), buf.String())
+		})
+	}
+
+	t.Run("should fall back or skip tasks with due dates that can't be parsed", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		err := Encode(buf, todoist.Tasks{
+			{ID: 1, Content: "FLOATING", Due: &todoist.Due{Date: "2016-12-06", Datetime: todoist.String("2016-12-06T13:00:00")}},
+			{ID: 2, Content: "INVALID", Due: &todoist.Due{Date: "INVALID"}},
+		}, &Options{Now: testNow})
+
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "UID:task-1@todoist.com\r\n")
+		assert.Contains(t, buf.String(), "DTSTART;VALUE=DATE:20161206\r\n")
+		assert.NotContains(t, buf.String(), "UID:task-2@todoist.com")
+		assert.True(t, strings.HasSuffix(buf.String(), "END:VCALENDAR\r\n"))
+	})
+
+	t.Run("should fold long lines", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		err := Encode(buf, todoist.Tasks{{ID: 1, Content: strings.Repeat("a", 100), Due: &todoist.Due{Date: "2022-01-05"}}}, &Options{Now: testNow})
+
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "SUMMARY:"+strings.Repeat("a", 67)+"\r\n "+strings.Repeat("a", 33)+"\r\n")
+	})
+}
+
+func TestRRule(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"every day", "FREQ=DAILY"},
+		{"daily", "FREQ=DAILY"},
+		{"every week at 9am", "FREQ=WEEKLY"},
+		{"every! 3 days", "FREQ=DAILY;INTERVAL=3"},
+		{"every other month", "FREQ=MONTHLY;INTERVAL=2"},
+		{"every weekday", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+		{"every mon, fri", "FREQ=WEEKLY;BYDAY=MO,FR"},
+		{"every Tuesday and Thursday", "FREQ=WEEKLY;BYDAY=TU,TH"},
+		{"every 3rd friday", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			assert.Equal(t, tt.want, RRule(tt.s))
+		})
+	}
+}
+
+type fakeTasksGetter struct {
+	opts  *todoist.GetTasksOptions
+	tasks todoist.Tasks
+	err   error
+}
+
+func (g *fakeTasksGetter) GetTasksWithOptions(opts *todoist.GetTasksOptions) (todoist.Tasks, error) {
+	g.opts = opts
+	return g.tasks, g.err
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("should serve a feed filtered by query parameters", func(t *testing.T) {
+		g := &fakeTasksGetter{tasks: todoist.Tasks{{ID: 1, Content: "TASK", Due: &todoist.Due{Date: "2022-01-05"}}}}
+		h := NewHandler(g, &HandlerOptions{ProjectID: todoist.Int(1), AllowQueryOverride: true, Options: Options{Now: testNow}})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/feed.ics?project_id=2&filter=today", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), "UID:task-1@todoist.com")
+		assert.Equal(t, &todoist.GetTasksOptions{ProjectID: todoist.Int(2), Filter: todoist.String("today")}, g.opts)
+	})
+
+	t.Run("should ignore query parameters by default", func(t *testing.T) {
+		g := &fakeTasksGetter{}
+		h := NewHandler(g, &HandlerOptions{ProjectID: todoist.Int(1), Options: Options{Now: testNow}})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/feed.ics?project_id=2&filter=all", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, &todoist.GetTasksOptions{ProjectID: todoist.Int(1)}, g.opts)
+	})
+
+	t.Run("should return an error if getting tasks fails", func(t *testing.T) {
+		h := NewHandler(&fakeTasksGetter{err: errors.New("ERROR")}, nil)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/feed.ics", nil))
+
+		assert.Equal(t, http.StatusBadGateway, rec.Code)
+	})
+}
@@ -133,3 +133,23 @@ func (cl *Client) DeleteLabelWithOptions(id int, opts *DeleteLabelOptions) error
 	}
 	return nil
 }
+
+// Label object of the Sync API.
+type syncLabel struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Color      int     `json:"color"`
+	ItemOrder  int     `json:"item_order"`
+	IsFavorite intBool `json:"is_favorite"`
+	IsDeleted  intBool `json:"is_deleted"`
+}
+
+func (slabel *syncLabel) label() *Label {
+	return &Label{
+		ID:       slabel.ID,
+		Name:     slabel.Name,
+		Color:    slabel.Color,
+		Order:    slabel.ItemOrder,
+		Favorite: bool(slabel.IsFavorite),
+	}
+}
@@ -0,0 +1,106 @@
+package todoist
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const redactedValue string = "REDACTED"
+
+type loggingAPI struct {
+	restAPI    restAPI
+	logger     *slog.Logger
+	logPayload bool
+}
+
+var _ restAPI = (*loggingAPI)(nil)
+
+func newLoggingAPI(api restAPI, logger *slog.Logger, logPayload bool) *loggingAPI {
+	return &loggingAPI{restAPI: api, logger: logger, logPayload: logPayload}
+}
+
+func (api *loggingAPI) Do(req *restRequest) (*restResponse, error) {
+	start := time.Now()
+	resp, err := api.restAPI.Do(req)
+	latency := time.Since(start)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", requestPath(req.URL)),
+		slog.Duration("latency", latency),
+		// The client does not retry requests, so every call is the first attempt.
+		slog.Int("attempt", 1),
+		slog.Any("headers", redactHeaders(req.Headers)),
+	}
+	if reqID, ok := req.Headers["X-Request-Id"]; ok {
+		attrs = append(attrs, slog.String("request_id", reqID))
+	}
+	if api.logPayload && req.Payload != nil {
+		attrs = append(attrs, slog.Any("payload", req.Payload))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		api.logger.LogAttrs(context.Background(), slog.LevelError, "todoist request failed", attrs...)
+		return nil, err
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if api.logPayload && resp.Body != nil {
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = bytes.NewReader(b)
+		attrs = append(attrs, slog.String("response", string(b)))
+	}
+
+	lv := slog.LevelInfo
+	if failedStatus(resp.StatusCode) {
+		lv = slog.LevelError
+	}
+	api.logger.LogAttrs(context.Background(), lv, "todoist request", attrs...)
+
+	return resp, nil
+}
+
+// Returns whether a response status is a failure.
+// 304 is not a failure, as it is the response of revalidating a cached response.
+func failedStatus(code int) bool {
+	return (code < 200 || 299 < code) && code != http.StatusNotModified
+}
+
+func requestPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Path
+}
+
+// Returns the path segments of a request URL without the API kind and version.
+// e.g. "https://api.todoist.com/rest/v1/tasks/1" => ["tasks", "1"]
+func resourceSegments(rawURL string) []string {
+	segs := strings.Split(strings.Trim(requestPath(rawURL), "/"), "/")
+	if len(segs) > 2 {
+		return segs[2:]
+	}
+	return segs[len(segs)-1:]
+}
+
+func redactHeaders(h map[string]string) map[string]string {
+	redacted := make(map[string]string, len(h))
+	for k, v := range h {
+		if k == "Authorization" {
+			v = redactedValue
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
@@ -0,0 +1,139 @@
+package todoist
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingAPI_Do(t *testing.T) {
+	type args struct {
+		logPayload bool
+	}
+	tests := []struct {
+		name     string
+		args     args
+		resp     *restResponse
+		respErr  error
+		wantLog  map[string]interface{}
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name: "should log a successful request",
+			args: args{logPayload: false},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "id": 1 }`),
+			},
+			wantLog: map[string]interface{}{
+				"level":      "INFO",
+				"msg":        "todoist request",
+				"method":     http.MethodPost,
+				"path":       "/rest/v1/tasks",
+				"status":     float64(http.StatusOK),
+				"attempt":    float64(1),
+				"request_id": "REQUEST_ID",
+				"headers":    map[string]interface{}{"Authorization": "REDACTED", "X-Request-Id": "REQUEST_ID"},
+			},
+			wantBody: `{ "id": 1 }`,
+			wantErr:  false,
+		},
+		{
+			name: "should log payloads if enabled",
+			args: args{logPayload: true},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "id": 1 }`),
+			},
+			wantLog: map[string]interface{}{
+				"level":    "INFO",
+				"payload":  map[string]interface{}{"content": "TASK"},
+				"response": `{ "id": 1 }`,
+			},
+			wantBody: `{ "id": 1 }`,
+			wantErr:  false,
+		},
+		{
+			name: "should log an error response",
+			args: args{logPayload: false},
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantLog: map[string]interface{}{
+				"level":  "ERROR",
+				"status": float64(http.StatusBadRequest),
+			},
+			wantBody: "ERROR_RESPONSE",
+			wantErr:  false,
+		},
+		{
+			name: "should log a not modified response",
+			args: args{logPayload: false},
+			resp: &restResponse{
+				StatusCode: http.StatusNotModified,
+				Body:       strings.NewReader(""),
+			},
+			wantLog: map[string]interface{}{
+				"level":  "INFO",
+				"status": float64(http.StatusNotModified),
+			},
+			wantBody: "",
+			wantErr:  false,
+		},
+		{
+			name:    "should log an error",
+			args:    args{logPayload: false},
+			respErr: errors.New("ERROR"),
+			wantLog: map[string]interface{}{
+				"level": "ERROR",
+				"msg":   "todoist request failed",
+				"error": "ERROR",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapi := &mockRestAPI{}
+			buf := new(bytes.Buffer)
+			api := newLoggingAPI(mapi, slog.New(slog.NewJSONHandler(buf, nil)), tt.args.logPayload)
+
+			req := &restRequest{
+				URL:     "https://api.todoist.com/rest/v1/tasks",
+				Method:  http.MethodPost,
+				Payload: map[string]interface{}{"content": "TASK"},
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "X-Request-Id": "REQUEST_ID"},
+			}
+			mapi.On("Do", req).Return(tt.resp, tt.respErr)
+
+			resp, err := api.Do(req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				b, _ := io.ReadAll(resp.Body)
+				assert.Equal(t, tt.wantBody, string(b))
+			}
+
+			var got map[string]interface{}
+			assert.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+			for k, v := range tt.wantLog {
+				assert.Equal(t, v, got[k], k)
+			}
+			assert.Contains(t, got, "latency")
+			assert.NotContains(t, buf.String(), "TOKEN")
+			mapi.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Version of the mirror file format.
+const mirrorVersion int = 1
+
+// Local copy of projects, sections, labels and active tasks persisted to a file.
+// It is kept up to date by Sync, and queries never access the network.
+type Mirror struct {
+	cl   *Client
+	path string
+
+	mu    sync.RWMutex
+	state mirrorState
+
+	tasksByProject  map[int][]int
+	tasksBySection  map[int][]int
+	tasksByLabel    map[int][]int
+	tasksByAssignee map[int][]int
+	tasksByDue      map[string][]int
+	// Sorted due dates of tasksByDue.
+	dueDates []string
+}
+
+// Persisted state of a mirror.
+type mirrorState struct {
+	Version   int              `json:"version"`
+	SyncToken string           `json:"sync_token"`
+	SyncedAt  time.Time        `json:"synced_at"`
+	Projects  map[int]*Project `json:"projects"`
+	Sections  map[int]*Section `json:"sections"`
+	Labels    map[int]*Label   `json:"labels"`
+	Tasks     map[int]*Task    `json:"tasks"`
+}
+
+// Query for tasks of a mirror.
+// All conditions that are set must match.
+type MirrorQuery struct {
+	// Filter tasks by project ID.
+	ProjectID *int
+	// Filter tasks by section ID.
+	SectionID *int
+	// Filter tasks by label ID.
+	LabelID *int
+	// Filter tasks by the responsible user ID.
+	AssigneeID *int
+	// Only tasks due on or after the date.
+	DueFrom *time.Time
+	// Only tasks due on or before the date.
+	DueTo *time.Time
+}
+
+// Opens a mirror persisted to a file.
+// If the file does not exist, the mirror is empty until Sync is called.
+func OpenMirror(cl *Client, path string) (*Mirror, error) {
+	m := &Mirror{cl: cl, path: path, state: newMirrorState()}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		m.index()
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := mirrorState{}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return nil, fmt.Errorf("invalid mirror file: %w", err)
+	}
+	if state.Version != mirrorVersion {
+		return nil, fmt.Errorf("unsupported mirror version: %d", state.Version)
+	}
+	m.state = state
+	m.index()
+
+	return m, nil
+}
+
+// Fetches changes since the last sync, or everything on the first sync, and saves the mirror.
+func (m *Mirror) Sync(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.mu.RLock()
+	token := m.state.SyncToken
+	m.mu.RUnlock()
+	if token == "" {
+		token = "*"
+	}
+
+	res := syncResources{}
+	resp, err := m.cl.WithContext(ctx).syncRead(token, []string{"projects", "sections", "labels", "items"}, &res)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.state
+	if resp.FullSync {
+		state = newMirrorState()
+	}
+	for _, sproj := range res.Projects {
+		if sproj.IsDeleted {
+			delete(state.Projects, sproj.ID)
+			continue
+		}
+		state.Projects[sproj.ID] = sproj.project()
+	}
+	for _, ssec := range res.Sections {
+		if ssec.IsDeleted {
+			delete(state.Sections, ssec.ID)
+			continue
+		}
+		state.Sections[ssec.ID] = ssec.section()
+	}
+	for _, slabel := range res.Labels {
+		if slabel.IsDeleted {
+			delete(state.Labels, slabel.ID)
+			continue
+		}
+		state.Labels[slabel.ID] = slabel.label()
+	}
+	for _, stask := range res.Items {
+		if stask.IsDeleted || stask.Checked {
+			delete(state.Tasks, stask.ID)
+			continue
+		}
+		state.Tasks[stask.ID] = stask.task()
+	}
+	state.SyncToken = resp.SyncToken
+	state.SyncedAt = time.Now().UTC()
+
+	m.state = state
+	m.index()
+
+	return m.save()
+}
+
+// Returns the date and time of the last sync, or the zero time if never synced.
+func (m *Mirror) SyncedAt() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.SyncedAt
+}
+
+// Returns a project.
+func (m *Mirror) Project(id int) (*Project, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	proj, ok := m.state.Projects[id]
+	if !ok {
+		return nil, false
+	}
+	return copyProject(proj), true
+}
+
+// Returns all projects ordered by ID.
+func (m *Mirror) Projects() Projects {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	projs := Projects{}
+	for _, proj := range m.state.Projects {
+		projs = append(projs, copyProject(proj))
+	}
+	sort.Slice(projs, func(i, j int) bool { return projs[i].ID < projs[j].ID })
+
+	return projs
+}
+
+// Returns sections of a project ordered by section order.
+func (m *Mirror) Sections(projectID int) Sections {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	secs := Sections{}
+	for _, sec := range m.state.Sections {
+		if sec.ProjectID == projectID {
+			cp := *sec
+			secs = append(secs, &cp)
+		}
+	}
+	sort.Slice(secs, func(i, j int) bool { return secs[i].Order < secs[j].Order })
+
+	return secs
+}
+
+// Returns all labels ordered by ID.
+func (m *Mirror) Labels() Labels {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	labels := Labels{}
+	for _, label := range m.state.Labels {
+		cp := *label
+		labels = append(labels, &cp)
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].ID < labels[j].ID })
+
+	return labels
+}
+
+// Returns an active task.
+func (m *Mirror) Task(id int) (*Task, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	task, ok := m.state.Tasks[id]
+	if !ok {
+		return nil, false
+	}
+	return copyTask(task), true
+}
+
+// Returns active tasks matching a query ordered by ID.
+// The comment count of tasks is not mirrored and is always 0.
+func (m *Mirror) Tasks(q MirrorQuery) Tasks {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	// candidates from the indexes of the conditions, or all tasks if no indexed condition is set.
+	var ids []int
+	all := true
+	narrow := func(idx []int) {
+		if all {
+			ids = idx
+			all = false
+			return
+		}
+		ids = intersectIDs(ids, idx)
+	}
+	if q.ProjectID != nil {
+		narrow(m.tasksByProject[*q.ProjectID])
+	}
+	if q.SectionID != nil {
+		narrow(m.tasksBySection[*q.SectionID])
+	}
+	if q.LabelID != nil {
+		narrow(m.tasksByLabel[*q.LabelID])
+	}
+	if q.AssigneeID != nil {
+		narrow(m.tasksByAssignee[*q.AssigneeID])
+	}
+	if q.DueFrom != nil || q.DueTo != nil {
+		narrow(m.tasksDueBetween(q.DueFrom, q.DueTo))
+	}
+
+	tasks := Tasks{}
+	if all {
+		for _, task := range m.state.Tasks {
+			tasks = append(tasks, copyTask(task))
+		}
+		sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+		return tasks
+	}
+	for _, id := range ids {
+		tasks = append(tasks, copyTask(m.state.Tasks[id]))
+	}
+
+	return tasks
+}
+
+// Returns sorted IDs of tasks due between the dates (inclusive).
+func (m *Mirror) tasksDueBetween(from, to *time.Time) []int {
+	start := 0
+	if from != nil {
+		d := from.Format("2006-01-02")
+		start = sort.SearchStrings(m.dueDates, d)
+	}
+	end := len(m.dueDates)
+	if to != nil {
+		d := to.Format("2006-01-02")
+		end = sort.Search(len(m.dueDates), func(i int) bool { return m.dueDates[i] > d })
+	}
+
+	ids := []int{}
+	for _, date := range m.dueDates[start:max(start, end)] {
+		ids = append(ids, m.tasksByDue[date]...)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// Rebuilds the indexes of tasks.
+func (m *Mirror) index() {
+	m.tasksByProject = map[int][]int{}
+	m.tasksBySection = map[int][]int{}
+	m.tasksByLabel = map[int][]int{}
+	m.tasksByAssignee = map[int][]int{}
+	m.tasksByDue = map[string][]int{}
+	m.dueDates = []string{}
+
+	ids := make([]int, 0, len(m.state.Tasks))
+	for id := range m.state.Tasks {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		task := m.state.Tasks[id]
+		m.tasksByProject[task.ProjectID] = append(m.tasksByProject[task.ProjectID], id)
+		m.tasksBySection[task.SectionID] = append(m.tasksBySection[task.SectionID], id)
+		for _, labelID := range task.LabelIDs {
+			m.tasksByLabel[labelID] = append(m.tasksByLabel[labelID], id)
+		}
+		if task.Assignee != nil {
+			m.tasksByAssignee[*task.Assignee] = append(m.tasksByAssignee[*task.Assignee], id)
+		}
+		if task.Due != nil && task.Due.Date != "" {
+			if _, ok := m.tasksByDue[task.Due.Date]; !ok {
+				m.dueDates = append(m.dueDates, task.Due.Date)
+			}
+			m.tasksByDue[task.Due.Date] = append(m.tasksByDue[task.Due.Date], id)
+		}
+	}
+	sort.Strings(m.dueDates)
+}
+
+// Writes the state to the file atomically.
+func (m *Mirror) save() error {
+	b, err := json.Marshal(m.state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.path), 0o700); err != nil {
+		return err
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, m.path)
+}
+
+func newMirrorState() mirrorState {
+	return mirrorState{
+		Version:  mirrorVersion,
+		Projects: map[int]*Project{},
+		Sections: map[int]*Section{},
+		Labels:   map[int]*Label{},
+		Tasks:    map[int]*Task{},
+	}
+}
+
+// Returns a deep copy of a project, so that callers can't modify the state.
+func copyProject(proj *Project) *Project {
+	cp := *proj
+	if proj.ParentID != nil {
+		cp.ParentID = Int(*proj.ParentID)
+	}
+	return &cp
+}
+
+// Returns a deep copy of a task, so that callers can't modify the state.
+func copyTask(task *Task) *Task {
+	cp := *task
+	cp.LabelIDs = append([]int{}, task.LabelIDs...)
+	if task.ParentID != nil {
+		cp.ParentID = Int(*task.ParentID)
+	}
+	if task.Assignee != nil {
+		cp.Assignee = Int(*task.Assignee)
+	}
+	if task.Due != nil {
+		due := *task.Due
+		for _, p := range []**string{&due.Datetime, &due.Timezone, &due.LocalDatetime} {
+			if *p != nil {
+				*p = String(**p)
+			}
+		}
+		cp.Due = &due
+	}
+	return &cp
+}
+
+// Returns IDs in both sorted lists.
+func intersectIDs(a, b []int) []int {
+	ids := []int{}
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			ids = append(ids, a[i])
+			i++
+			j++
+		}
+	}
+	return ids
+}
@@ -0,0 +1,144 @@
+package todoist
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newMirrorRequestForTest(syncToken string) *restRequest {
+	return withContextForTest(context.Background(), newSyncRequestForTest(map[string]interface{}{"sync_token": syncToken, "resource_types": []string{"projects", "sections", "labels", "items"}}))
+}
+
+const mirrorFullSyncResponseForTest = `{
+	"sync_token": "T1", "full_sync": true,
+	"projects": [{ "id": 1, "name": "WORK", "child_order": 1 }, { "id": 2, "name": "HOME", "child_order": 2 }],
+	"sections": [{ "id": 10, "project_id": 1, "name": "DOING", "section_order": 1 }],
+	"labels": [{ "id": 100, "name": "urgent", "item_order": 1 }],
+	"items": [
+		{ "id": 1000, "project_id": 1, "section_id": 10, "content": "A", "labels": [100], "responsible_uid": 7, "due": { "date": "2026-10-18", "string": "today" } },
+		{ "id": 1001, "project_id": 1, "content": "B", "labels": [], "due": { "date": "2026-10-20T09:00:00Z", "timezone": "UTC", "string": "Oct 20 9am" } },
+		{ "id": 1002, "project_id": 2, "content": "C", "labels": [100], "responsible_uid": 7 }
+	]
+}`
+
+func TestMirror_Sync(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mirror.json")
+	cl, api := newClientForTest()
+	api.On("Do", newMirrorRequestForTest("*")).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(mirrorFullSyncResponseForTest)}, nil).Once()
+	api.On("Do", newMirrorRequestForTest("T1")).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{
+		"sync_token": "T2", "full_sync": false,
+		"projects": [{ "id": 2, "name": "HOME", "child_order": 2, "is_deleted": 1 }],
+		"items": [
+			{ "id": 1001, "project_id": 1, "content": "B", "checked": 1 },
+			{ "id": 1002, "project_id": 2, "content": "C", "is_deleted": 1 },
+			{ "id": 1003, "project_id": 1, "section_id": 10, "content": "D", "labels": [100] }
+		]
+	}`)}, nil).Once()
+
+	m, err := OpenMirror(cl, path)
+	assert.NoError(t, err)
+	assert.Empty(t, m.Tasks(MirrorQuery{}))
+
+	assert.NoError(t, m.Sync(context.Background()))
+	assert.Len(t, m.Projects(), 2)
+	assert.Len(t, m.Tasks(MirrorQuery{}), 3)
+
+	assert.NoError(t, m.Sync(context.Background()))
+	assert.Equal(t, Projects{{ID: 1, Name: "WORK", Order: 1, URL: "https://todoist.com/showProject?id=1"}}, m.Projects())
+	assert.Equal(t, []int{1000, 1003}, taskIDsForTest(m.Tasks(MirrorQuery{})))
+	api.AssertExpectations(t)
+
+	// reopening the mirror does not access the network.
+	reopened, err := OpenMirror(cl, path)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1000, 1003}, taskIDsForTest(reopened.Tasks(MirrorQuery{})))
+	assert.Equal(t, Sections{{ID: 10, ProjectID: 1, Name: "DOING", Order: 1}}, reopened.Sections(1))
+	assert.Equal(t, Labels{{ID: 100, Name: "urgent", Order: 1}}, reopened.Labels())
+	assert.False(t, reopened.SyncedAt().IsZero())
+	api.AssertNumberOfCalls(t, "Do", 2)
+}
+
+func TestMirror_Tasks(t *testing.T) {
+	cl, api := newClientForTest()
+	api.On("Do", newMirrorRequestForTest("*")).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(mirrorFullSyncResponseForTest)}, nil)
+	m, err := OpenMirror(cl, filepath.Join(t.TempDir(), "mirror.json"))
+	assert.NoError(t, err)
+	assert.NoError(t, m.Sync(context.Background()))
+
+	day := func(d int) *time.Time {
+		tm := time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+		return &tm
+	}
+	tests := []struct {
+		name  string
+		query MirrorQuery
+		want  []int
+	}{
+		{name: "should return all tasks", query: MirrorQuery{}, want: []int{1000, 1001, 1002}},
+		{name: "should filter by project", query: MirrorQuery{ProjectID: Int(1)}, want: []int{1000, 1001}},
+		{name: "should filter by section", query: MirrorQuery{SectionID: Int(10)}, want: []int{1000}},
+		{name: "should filter by label", query: MirrorQuery{LabelID: Int(100)}, want: []int{1000, 1002}},
+		{name: "should filter by assignee", query: MirrorQuery{AssigneeID: Int(7)}, want: []int{1000, 1002}},
+		{name: "should filter by due range", query: MirrorQuery{DueFrom: day(19), DueTo: day(20)}, want: []int{1001}},
+		{name: "should filter by due date from", query: MirrorQuery{DueFrom: day(18)}, want: []int{1000, 1001}},
+		{name: "should filter by due date to", query: MirrorQuery{DueTo: day(18)}, want: []int{1000}},
+		{name: "should combine conditions", query: MirrorQuery{LabelID: Int(100), ProjectID: Int(2)}, want: []int{1002}},
+		{name: "should return no tasks if nothing matches", query: MirrorQuery{ProjectID: Int(3)}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, taskIDsForTest(m.Tasks(tt.query)))
+		})
+	}
+
+	task, ok := m.Task(1000)
+	assert.True(t, ok)
+	assert.Equal(t, &Task{ID: 1000, ProjectID: 1, SectionID: 10, Content: "A", LabelIDs: []int{100}, Assignee: Int(7), Due: &Due{Date: "2026-10-18", String: "today"}, URL: "https://todoist.com/showTask?id=1000"}, task)
+	_, ok = m.Task(9999)
+	assert.False(t, ok)
+
+	// modifying returned tasks does not change the mirror.
+	task.LabelIDs[0] = 999
+	*task.Assignee = 999
+	task.Due.Date = "2000-01-01"
+	for _, task := range m.Tasks(MirrorQuery{}) {
+		task.LabelIDs = append(task.LabelIDs[:0], 999)
+		if task.Due != nil {
+			task.Due.Date = "2000-01-01"
+		}
+	}
+	assert.Equal(t, []int{1000, 1002}, taskIDsForTest(m.Tasks(MirrorQuery{LabelID: Int(100)})))
+	task, _ = m.Task(1000)
+	assert.Equal(t, []int{100}, task.LabelIDs)
+	assert.Equal(t, Int(7), task.Assignee)
+	assert.Equal(t, "2026-10-18", task.Due.Date)
+}
+
+func TestOpenMirror(t *testing.T) {
+	t.Run("should return an error if the request fails and keep the mirror empty", func(t *testing.T) {
+		cl, api := newClientForTest()
+		api.On("Do", newMirrorRequestForTest("*")).Return(&restResponse{StatusCode: http.StatusBadRequest, Body: strings.NewReader("ERROR_RESPONSE")}, nil)
+		m, err := OpenMirror(cl, filepath.Join(t.TempDir(), "mirror.json"))
+		assert.NoError(t, err)
+
+		err = m.Sync(context.Background())
+
+		assert.IsType(t, RequestError{}, err)
+		assert.Empty(t, m.Projects())
+		api.AssertExpectations(t)
+	})
+}
+
+func taskIDsForTest(tasks Tasks) []int {
+	ids := []int{}
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
@@ -2,6 +2,7 @@ package todoist
 
 import (
 	"fmt"
+	"sort"
 )
 
 type Project struct {
@@ -33,6 +34,8 @@ type Project struct {
 	SyncID int `json:"sync_id"`
 	// URL to access this project in the Todoist web or mobile applications.
 	URL string `json:"url"`
+	// Whether the project is archived (read-only, use ArchiveProject and UnarchiveProject to change).
+	IsArchived bool `json:"is_archived"`
 }
 
 // List of Projects.
@@ -160,3 +163,119 @@ func (cl *Client) GetCollaborators(projectID int) (Users, error) {
 
 	return users, nil
 }
+
+// Reorders projects under the same parent in the order of IDs.
+func (cl *Client) ReorderProjects(ids []int) error {
+	projs := []map[string]interface{}{}
+	for i, id := range ids {
+		projs = append(projs, map[string]interface{}{"id": id, "child_order": i + 1})
+	}
+
+	if _, err := cl.syncCommands(cl.newCommand("project_reorder", map[string]interface{}{"projects": projs})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sorts projects under the same parent by less and reorders them in that order.
+func (cl *Client) SortProjects(projs Projects, less func(a, b *Project) bool) error {
+	sorted := make(Projects, len(projs))
+	copy(sorted, projs)
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+
+	ids := []int{}
+	for _, proj := range sorted {
+		ids = append(ids, proj.ID)
+	}
+
+	return cl.ReorderProjects(ids)
+}
+
+// Archives a project and its descendants.
+func (cl *Client) ArchiveProject(id int) error {
+	if _, err := cl.syncCommands(cl.newCommand("project_archive", map[string]interface{}{"id": id})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Unarchives a project.
+func (cl *Client) UnarchiveProject(id int) error {
+	if _, err := cl.syncCommands(cl.newCommand("project_unarchive", map[string]interface{}{"id": id})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Options for getting archived projects.
+type GetArchivedProjectsOptions struct {
+	// Maximum number of projects to return.
+	Limit *int `json:"limit,omitempty"`
+	// Number of projects to skip, used for pagination.
+	Offset *int `json:"offset,omitempty"`
+}
+
+// Gets list of archived projects.
+func (cl *Client) GetArchivedProjects() (Projects, error) {
+	return cl.GetArchivedProjectsWithOptions(nil)
+}
+
+// Gets list of archived projects with options.
+func (cl *Client) GetArchivedProjectsWithOptions(opts *GetArchivedProjectsOptions) (Projects, error) {
+	p := map[string]interface{}{}
+	if err := toMap(opts, p); err != nil {
+		return nil, err
+	}
+
+	sprojs := []*syncProject{}
+	if err := cl.syncPost("/v8/projects/get_archived", p, &sprojs); err != nil {
+		return nil, err
+	}
+
+	projs := Projects{}
+	for _, sproj := range sprojs {
+		projs = append(projs, sproj.project())
+	}
+
+	return projs, nil
+}
+
+// Project object of the Sync API.
+type syncProject struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Color        int     `json:"color"`
+	ParentID     *int    `json:"parent_id"`
+	ChildOrder   int     `json:"child_order"`
+	Shared       bool    `json:"shared"`
+	IsFavorite   intBool `json:"is_favorite"`
+	InboxProject bool    `json:"inbox_project"`
+	TeamInbox    bool    `json:"team_inbox"`
+	SyncID       *int    `json:"sync_id"`
+	IsArchived   intBool `json:"is_archived"`
+	IsDeleted    intBool `json:"is_deleted"`
+}
+
+func (sproj *syncProject) project() *Project {
+	proj := &Project{
+		ID:           sproj.ID,
+		Name:         sproj.Name,
+		Color:        sproj.Color,
+		ParentID:     sproj.ParentID,
+		Order:        sproj.ChildOrder,
+		Shared:       sproj.Shared,
+		Favorite:     bool(sproj.IsFavorite),
+		InboxProject: sproj.InboxProject,
+		TeamInbox:    sproj.TeamInbox,
+		URL:          fmt.Sprintf("https://todoist.com/showProject?id=%d", sproj.ID),
+		IsArchived:   bool(sproj.IsArchived),
+	}
+	if sproj.SyncID != nil {
+		proj.SyncID = *sproj.SyncID
+	}
+
+	return proj
+}
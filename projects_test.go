@@ -450,3 +450,230 @@ func TestClient_GetCollaborators(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ReorderProjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should return nil",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the command fails",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": { "error_code": 20, "error": "ERROR" } } }`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", newSyncCommandsRequestForTest(&syncCommand{
+				Type: "project_reorder",
+				UUID: "UUID_1",
+				Args: map[string]interface{}{"projects": []map[string]interface{}{
+					{"id": 3, "child_order": 1},
+					{"id": 1, "child_order": 2},
+				}},
+			})).Return(tt.resp, nil)
+
+			err := cl.ReorderProjects([]int{3, 1})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_SortProjects(t *testing.T) {
+	t.Run("should reorder sorted projects", func(t *testing.T) {
+		cl, api := newClientForTest()
+
+		api.On("Do", newSyncCommandsRequestForTest(&syncCommand{
+			Type: "project_reorder",
+			UUID: "UUID_1",
+			Args: map[string]interface{}{"projects": []map[string]interface{}{
+				{"id": 2, "child_order": 1},
+				{"id": 3, "child_order": 2},
+				{"id": 1, "child_order": 3},
+			}},
+		})).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+		}, nil)
+
+		items := Projects{{ID: 1, Order: 30}, {ID: 2, Order: 10}, {ID: 3, Order: 20}}
+		err := cl.SortProjects(items, func(a, b *Project) bool { return a.Order < b.Order })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, items[0].ID)
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_ArchiveProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should return nil",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", newSyncCommandsRequestForTest(
+				&syncCommand{Type: "project_archive", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}},
+			)).Return(tt.resp, nil)
+
+			err := cl.ArchiveProject(1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_UnarchiveProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should return nil",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", newSyncCommandsRequestForTest(
+				&syncCommand{Type: "project_unarchive", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}},
+			)).Return(tt.resp, nil)
+
+			err := cl.UnarchiveProject(1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_GetArchivedProjectsWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *GetArchivedProjectsOptions
+		payload map[string]interface{}
+		resp    *restResponse
+		want    Projects
+		wantErr bool
+	}{
+		{
+			name:    "should return archived projects",
+			opts:    &GetArchivedProjectsOptions{Limit: Int(10), Offset: Int(20)},
+			payload: map[string]interface{}{"limit": Int(10), "offset": Int(20)},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`[{ "id": 1, "name": "PROJECT", "child_order": 2, "is_favorite": 1, "is_archived": 1, "sync_id": null }]`),
+			},
+			want:    Projects{{ID: 1, Name: "PROJECT", Order: 2, Favorite: true, IsArchived: true, URL: "https://todoist.com/showProject?id=1"}},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error if the request fails",
+			opts:    nil,
+			payload: map[string]interface{}{},
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", &restRequest{
+				URL:     "https://api.todoist.com/sync/v8/projects/get_archived",
+				Method:  http.MethodPost,
+				Payload: tt.payload,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+			}).Return(tt.resp, nil)
+
+			projs, err := cl.GetArchivedProjectsWithOptions(tt.opts)
+
+			assert.Equal(t, tt.want, projs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
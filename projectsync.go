@@ -0,0 +1,593 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Version of the project sync state file format.
+const projectSyncVersion int = 1
+
+// Strategy for tasks changed in both accounts since the last sync.
+type SyncStrategy int
+
+const (
+	// Keeps the task updated last according to the activity log of each account.
+	// The source wins if the times are equal or unknown.
+	SyncLastWriterWins SyncStrategy = iota
+	// Keeps the task of the source account.
+	SyncPreferSource
+)
+
+// Returned when no project of the target account matches the source project.
+var ErrProjectNotMatched = errors.New("no matching project in the target account")
+
+// Options for synchronizing a project between two accounts.
+type ProjectSyncOptions struct {
+	// ID of the project in the source account.
+	SourceProjectID int
+	// ID of the project in the target account.
+	// If not set, the project with the same SyncID, or the same name for non-shared projects, is used.
+	TargetProjectID *int
+	// Path of the file the mapping between the accounts is persisted to.
+	StatePath string
+	// Strategy for tasks changed in both accounts.
+	// Default is SyncLastWriterWins.
+	Strategy SyncStrategy
+}
+
+// Number of changes applied to an account.
+type SyncCounts struct {
+	// Number of created tasks.
+	Created int
+	// Number of updated tasks.
+	Updated int
+	// Number of closed tasks.
+	Completed int
+	// Number of created comments.
+	Comments int
+}
+
+// Result of synchronizing a project.
+type ProjectSyncResult struct {
+	// Changes applied to the target account.
+	ToTarget SyncCounts
+	// Changes applied to the source account.
+	ToSource SyncCounts
+	// Number of tasks changed in both accounts.
+	Conflicts int
+}
+
+// Two-way synchronization of a project between two accounts.
+// Task creations, completions, edits of content, description, priority and due date, and new comments are propagated.
+// Tasks that disappear from one account (completed or deleted) are closed in the other.
+type ProjectSyncer struct {
+	src  *Client
+	dst  *Client
+	opts ProjectSyncOptions
+
+	state projectSyncState
+}
+
+// Persisted state of a project sync.
+type projectSyncState struct {
+	Version         int `json:"version"`
+	SourceProjectID int `json:"source_project_id"`
+	TargetProjectID int `json:"target_project_id"`
+	// Mapping from source section IDs to target section IDs.
+	Sections map[int]int `json:"sections"`
+	// Mapped tasks.
+	Tasks []*syncedTask `json:"tasks"`
+	// Mapping from source comment IDs to target comment IDs.
+	Comments map[int]int `json:"comments"`
+	SyncedAt time.Time   `json:"synced_at"`
+}
+
+// Pair of tasks kept in sync.
+type syncedTask struct {
+	SourceID int `json:"source_id"`
+	TargetID int `json:"target_id"`
+	// Fields of both tasks at the last sync.
+	Base *syncedFields `json:"base"`
+}
+
+// Fields of a task kept in sync.
+type syncedFields struct {
+	Content     string `json:"content"`
+	Description string `json:"description"`
+	Priority    int    `json:"priority"`
+	DueDate     string `json:"due_date,omitempty"`
+	DueDatetime string `json:"due_datetime,omitempty"`
+	// Only set for recurring due dates, which are kept by the string.
+	DueString string `json:"due_string,omitempty"`
+}
+
+// Returns new project syncer.
+// If the state file exists, the mapping is restored from it.
+func NewProjectSyncer(src, dst *Client, opts ProjectSyncOptions) (*ProjectSyncer, error) {
+	s := &ProjectSyncer{
+		src:   src,
+		dst:   dst,
+		opts:  opts,
+		state: projectSyncState{Version: projectSyncVersion, Sections: map[int]int{}, Tasks: []*syncedTask{}, Comments: map[int]int{}},
+	}
+
+	b, err := os.ReadFile(opts.StatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := projectSyncState{}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return nil, fmt.Errorf("invalid project sync state file: %w", err)
+	}
+	if state.Version != projectSyncVersion {
+		return nil, fmt.Errorf("unsupported project sync state version: %d", state.Version)
+	}
+	if state.SourceProjectID != opts.SourceProjectID {
+		return nil, fmt.Errorf("project sync state is for another project: %d", state.SourceProjectID)
+	}
+	if state.Sections == nil {
+		state.Sections = map[int]int{}
+	}
+	if state.Comments == nil {
+		state.Comments = map[int]int{}
+	}
+	s.state = state
+
+	return s, nil
+}
+
+// Synchronizes the project once and saves the state.
+// The state is saved even if it fails halfway, so that applied changes are not applied again.
+func (s *ProjectSyncer) Run(ctx context.Context) (*ProjectSyncResult, error) {
+	res := &ProjectSyncResult{}
+	err := s.run(ctx, res)
+	if serr := s.save(); err == nil {
+		err = serr
+	}
+
+	return res, err
+}
+
+func (s *ProjectSyncer) run(ctx context.Context, res *ProjectSyncResult) error {
+	if err := s.resolveProjects(); err != nil {
+		return err
+	}
+	s.state.SourceProjectID = s.opts.SourceProjectID
+	if err := s.syncSections(); err != nil {
+		return err
+	}
+
+	srcTasks, err := s.src.GetTasksWithOptions(&GetTasksOptions{ProjectID: &s.state.SourceProjectID})
+	if err != nil {
+		return err
+	}
+	dstTasks, err := s.dst.GetTasksWithOptions(&GetTasksOptions{ProjectID: &s.state.TargetProjectID})
+	if err != nil {
+		return err
+	}
+	srcByID := map[int]*Task{}
+	for _, task := range srcTasks {
+		srcByID[task.ID] = task
+	}
+	dstByID := map[int]*Task{}
+	for _, task := range dstTasks {
+		dstByID[task.ID] = task
+	}
+
+	// mapped tasks
+	pairs := s.state.Tasks
+	s.state.Tasks = []*syncedTask{}
+	srcMapped := map[int]int{}
+	dstMapped := map[int]bool{}
+	// tasks closed because the other task is gone.
+	srcClosed := map[int]bool{}
+	for i, pair := range pairs {
+		if err := ctx.Err(); err != nil {
+			s.state.Tasks = append(s.state.Tasks, pairs[i:]...)
+			return err
+		}
+
+		st, sok := srcByID[pair.SourceID]
+		dt, dok := dstByID[pair.TargetID]
+		switch {
+		case sok && dok:
+			if err := s.syncTask(pair, st, dt, res); err != nil {
+				s.state.Tasks = append(s.state.Tasks, pairs[i:]...)
+				return err
+			}
+			s.state.Tasks = append(s.state.Tasks, pair)
+			srcMapped[st.ID] = dt.ID
+			dstMapped[dt.ID] = true
+		case sok:
+			if err := s.src.CloseTask(st.ID); err != nil {
+				s.state.Tasks = append(s.state.Tasks, pairs[i:]...)
+				return err
+			}
+			res.ToSource.Completed++
+			srcClosed[st.ID] = true
+		case dok:
+			if err := s.dst.CloseTask(dt.ID); err != nil {
+				s.state.Tasks = append(s.state.Tasks, pairs[i:]...)
+				return err
+			}
+			res.ToTarget.Completed++
+			dstMapped[dt.ID] = true
+		}
+	}
+
+	// new source tasks, matched by content or created in the target
+	dstIDs := map[int]int{}
+	for _, st := range sortTaskTree(srcTasks) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, ok := srcMapped[st.ID]; ok || srcClosed[st.ID] {
+			continue
+		}
+
+		var dt *Task
+		for _, t := range dstTasks {
+			if !dstMapped[t.ID] && t.Content == st.Content {
+				dt = t
+				break
+			}
+		}
+
+		pair := &syncedTask{SourceID: st.ID}
+		if dt != nil {
+			pair.TargetID = dt.ID
+			if err := s.syncTask(pair, st, dt, res); err != nil {
+				return err
+			}
+		} else {
+			created, err := s.dst.CreateTaskWithOptions(st.Content, s.createOptions(st, s.state.TargetProjectID, s.state.Sections, srcMapped))
+			if err != nil {
+				return err
+			}
+			res.ToTarget.Created++
+			pair.TargetID = created.ID
+			fields := taskFields(st)
+			pair.Base = &fields
+			dstByID[created.ID] = created
+		}
+		s.state.Tasks = append(s.state.Tasks, pair)
+		srcMapped[st.ID] = pair.TargetID
+		dstMapped[pair.TargetID] = true
+	}
+	for srcID, dstID := range srcMapped {
+		dstIDs[dstID] = srcID
+	}
+
+	// new target tasks created in the source
+	srcSections := map[int]int{}
+	for srcID, dstID := range s.state.Sections {
+		srcSections[dstID] = srcID
+	}
+	for _, dt := range sortTaskTree(dstTasks) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if dstMapped[dt.ID] {
+			continue
+		}
+
+		created, err := s.src.CreateTaskWithOptions(dt.Content, s.createOptions(dt, s.state.SourceProjectID, srcSections, dstIDs))
+		if err != nil {
+			return err
+		}
+		res.ToSource.Created++
+		fields := taskFields(dt)
+		s.state.Tasks = append(s.state.Tasks, &syncedTask{SourceID: created.ID, TargetID: dt.ID, Base: &fields})
+		srcByID[created.ID] = created
+		dstIDs[dt.ID] = created.ID
+		dstMapped[dt.ID] = true
+	}
+
+	// comments
+	for _, pair := range s.state.Tasks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		st, dt := srcByID[pair.SourceID], dstByID[pair.TargetID]
+		if st == nil || dt == nil || (st.CommentCount == 0 && dt.CommentCount == 0) {
+			continue
+		}
+		if err := s.syncComments(pair, st, dt, res); err != nil {
+			return err
+		}
+	}
+
+	s.state.SyncedAt = time.Now().UTC()
+	return nil
+}
+
+// Resolves the target project by the options, the state, SyncID or name.
+func (s *ProjectSyncer) resolveProjects() error {
+	if s.opts.TargetProjectID != nil {
+		s.state.TargetProjectID = *s.opts.TargetProjectID
+		return nil
+	}
+	if s.state.TargetProjectID != 0 {
+		return nil
+	}
+
+	src, err := s.src.GetProject(s.opts.SourceProjectID)
+	if err != nil {
+		return err
+	}
+	projs, err := s.dst.GetProjects()
+	if err != nil {
+		return err
+	}
+	for _, proj := range projs {
+		if src.SyncID != 0 && proj.SyncID == src.SyncID {
+			s.state.TargetProjectID = proj.ID
+			return nil
+		}
+	}
+	for _, proj := range projs {
+		if src.SyncID == 0 && proj.Name == src.Name {
+			s.state.TargetProjectID = proj.ID
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q", ErrProjectNotMatched, src.Name)
+}
+
+// Maps sections by name, creating missing sections in the other account.
+func (s *ProjectSyncer) syncSections() error {
+	srcSecs, err := s.src.GetSectionsWithOptions(&GetSectionsOptions{ProjectID: &s.state.SourceProjectID})
+	if err != nil {
+		return err
+	}
+	dstSecs, err := s.dst.GetSectionsWithOptions(&GetSectionsOptions{ProjectID: &s.state.TargetProjectID})
+	if err != nil {
+		return err
+	}
+
+	dstByName := map[string]int{}
+	for _, sec := range dstSecs {
+		dstByName[sec.Name] = sec.ID
+	}
+	srcNames := map[string]bool{}
+	for _, sec := range srcSecs {
+		srcNames[sec.Name] = true
+		if id, ok := dstByName[sec.Name]; ok {
+			s.state.Sections[sec.ID] = id
+			continue
+		}
+		created, err := s.dst.CreateSection(sec.Name, s.state.TargetProjectID)
+		if err != nil {
+			return err
+		}
+		s.state.Sections[sec.ID] = created.ID
+	}
+	for _, sec := range dstSecs {
+		if srcNames[sec.Name] {
+			continue
+		}
+		created, err := s.src.CreateSection(sec.Name, s.state.SourceProjectID)
+		if err != nil {
+			return err
+		}
+		s.state.Sections[created.ID] = sec.ID
+	}
+
+	return nil
+}
+
+// Propagates changes of a pair of tasks since the last sync.
+func (s *ProjectSyncer) syncTask(pair *syncedTask, st, dt *Task, res *ProjectSyncResult) error {
+	sf, df := taskFields(st), taskFields(dt)
+	if sf == df {
+		pair.Base = &sf
+		return nil
+	}
+
+	srcChanged := pair.Base == nil || sf != *pair.Base
+	dstChanged := pair.Base == nil || df != *pair.Base
+	sourceWins := srcChanged
+	if srcChanged && dstChanged {
+		res.Conflicts++
+		sourceWins = s.sourceWins(st.ID, dt.ID)
+	}
+
+	if sourceWins {
+		if err := s.dst.UpdateTaskWithOptions(dt.ID, sf.updateOptions()); err != nil {
+			return err
+		}
+		res.ToTarget.Updated++
+		pair.Base = &sf
+	} else {
+		if err := s.src.UpdateTaskWithOptions(st.ID, df.updateOptions()); err != nil {
+			return err
+		}
+		res.ToSource.Updated++
+		pair.Base = &df
+	}
+
+	return nil
+}
+
+// Returns whether the source task wins a conflict.
+func (s *ProjectSyncer) sourceWins(srcID, dstID int) bool {
+	if s.opts.Strategy == SyncPreferSource {
+		return true
+	}
+
+	srcAt, ok := lastUpdatedAt(s.src, srcID)
+	if !ok {
+		return true
+	}
+	dstAt, ok := lastUpdatedAt(s.dst, dstID)
+	if !ok {
+		return true
+	}
+
+	return !dstAt.After(srcAt)
+}
+
+// Copies comments not yet mapped to the other task, matching existing comments by content.
+func (s *ProjectSyncer) syncComments(pair *syncedTask, st, dt *Task, res *ProjectSyncResult) error {
+	srcCmts, dstCmts := Comments{}, Comments{}
+	var err error
+	if st.CommentCount > 0 {
+		if srcCmts, err = s.src.GetTaskComments(st.ID); err != nil {
+			return err
+		}
+	}
+	if dt.CommentCount > 0 {
+		if dstCmts, err = s.dst.GetTaskComments(dt.ID); err != nil {
+			return err
+		}
+	}
+
+	dstMapped := map[int]bool{}
+	for _, id := range s.state.Comments {
+		dstMapped[id] = true
+	}
+
+	for _, cmt := range srcCmts {
+		if _, ok := s.state.Comments[cmt.ID]; ok {
+			continue
+		}
+		matched := false
+		for _, dcmt := range dstCmts {
+			if !dstMapped[dcmt.ID] && dcmt.Content == cmt.Content {
+				s.state.Comments[cmt.ID] = dcmt.ID
+				dstMapped[dcmt.ID] = true
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+		created, err := s.dst.CreateTaskCommentWithOptions(pair.TargetID, cmt.Content, &CreateTaskCommentOptions{Attachment: cloneAttachment(cmt.Attachment)})
+		if err != nil {
+			return err
+		}
+		res.ToTarget.Comments++
+		s.state.Comments[cmt.ID] = created.ID
+		dstMapped[created.ID] = true
+	}
+
+	for _, dcmt := range dstCmts {
+		if dstMapped[dcmt.ID] {
+			continue
+		}
+		created, err := s.src.CreateTaskCommentWithOptions(pair.SourceID, dcmt.Content, &CreateTaskCommentOptions{Attachment: cloneAttachment(dcmt.Attachment)})
+		if err != nil {
+			return err
+		}
+		res.ToSource.Comments++
+		s.state.Comments[created.ID] = dcmt.ID
+	}
+
+	return nil
+}
+
+// Returns options for creating a copy of a task, mapping its section and parent.
+func (s *ProjectSyncer) createOptions(task *Task, projectID int, sections map[int]int, tasks map[int]int) *CreateTaskOptions {
+	f := taskFields(task)
+	opts := &CreateTaskOptions{ProjectID: &projectID, Priority: &f.Priority}
+	if f.Description != "" {
+		opts.Description = &f.Description
+	}
+	if id, ok := sections[task.SectionID]; ok {
+		opts.SectionID = &id
+	}
+	if task.ParentID != nil {
+		if id, ok := tasks[*task.ParentID]; ok {
+			opts.ParentID = &id
+		}
+	}
+	switch {
+	case f.DueString != "":
+		opts.DueString = &f.DueString
+	case f.DueDatetime != "":
+		opts.DueDatetime = &f.DueDatetime
+	case f.DueDate != "":
+		opts.DueDate = &f.DueDate
+	}
+
+	return opts
+}
+
+// Writes the state to the file atomically.
+func (s *ProjectSyncer) save() error {
+	b, err := json.Marshal(s.state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.opts.StatePath), 0o700); err != nil {
+		return err
+	}
+	tmp := s.opts.StatePath + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.opts.StatePath)
+}
+
+func taskFields(task *Task) syncedFields {
+	f := syncedFields{Content: task.Content, Description: task.Description, Priority: task.Priority}
+	if task.Due != nil {
+		// the date of a due datetime depends on the timezone of the account, so only the datetime is compared.
+		if task.Due.Datetime != nil {
+			f.DueDatetime = *task.Due.Datetime
+		} else {
+			f.DueDate = task.Due.Date
+		}
+		if task.Due.Recurring {
+			f.DueString = task.Due.String
+		}
+	}
+
+	return f
+}
+
+func (f syncedFields) updateOptions() *UpdateTaskOptions {
+	opts := &UpdateTaskOptions{Content: &f.Content, Description: &f.Description, Priority: &f.Priority}
+	switch {
+	case f.DueString != "":
+		opts.DueString = &f.DueString
+	case f.DueDatetime != "":
+		opts.DueDatetime = &f.DueDatetime
+	case f.DueDate != "":
+		opts.DueDate = &f.DueDate
+	default:
+		opts.DueString = String("no date")
+	}
+
+	return opts
+}
+
+// Returns the date and time of the last update of a task from the activity log.
+// It returns false if the time is unknown, including when the activity log can't be read (e.g. without premium).
+func lastUpdatedAt(cl *Client, taskID int) (time.Time, bool) {
+	objType, evType := ActivityObjectTask, ActivityEventUpdated
+	it := cl.GetActivity(ActivityOptions{ObjectType: &objType, ObjectID: &taskID, EventType: &evType, PageSize: Int(1)})
+	if !it.Next() {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, it.Event().EventDate)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,259 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mockGetForTest(api *mockRestAPI, url, body string) {
+	api.On("Do", &restRequest{
+		URL:     url,
+		Method:  http.MethodGet,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+	}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil)
+}
+
+func mockPostForTest(api *mockRestAPI, url string, payload map[string]interface{}, status int, body string) {
+	headers := map[string]string{"Authorization": "Bearer TOKEN"}
+	if payload != nil {
+		headers["Content-Type"] = "application/json"
+	}
+	api.On("Do", &restRequest{
+		URL:     url,
+		Method:  http.MethodPost,
+		Payload: payload,
+		Headers: headers,
+	}).Return(&restResponse{StatusCode: status, Body: strings.NewReader(body)}, nil)
+}
+
+func writeProjectSyncStateForTest(t *testing.T, path string, state projectSyncState) {
+	b, err := json.Marshal(state)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, b, 0o600))
+}
+
+func TestProjectSyncer_Run(t *testing.T) {
+	t.Run("should match the project and tasks and copy new tasks and comments both ways", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "sync.json")
+		src, srcAPI := newClientForTest()
+		dst, dstAPI := newClientForTest()
+
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/projects/1", `{ "id": 1, "name": "CLIENT", "sync_id": 555 }`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/projects", `[{ "id": 9, "name": "CLIENT", "sync_id": 0 }, { "id": 2, "name": "CLIENT (shared)", "sync_id": 555 }]`)
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/sections?project_id=1", `[{ "id": 10, "project_id": 1, "name": "SECTION" }]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/sections?project_id=2", `[]`)
+		mockPostForTest(dstAPI, "https://api.todoist.com/rest/v1/sections", map[string]interface{}{"name": "SECTION", "project_id": 2}, http.StatusOK, `{ "id": 20, "project_id": 2, "name": "SECTION" }`)
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks?project_id=1", `[
+			{ "id": 100, "project_id": 1, "section_id": 10, "content": "A", "priority": 1, "comment_count": 1 },
+			{ "id": 101, "project_id": 1, "content": "SHARED", "priority": 1 }
+		]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks?project_id=2", `[
+			{ "id": 200, "project_id": 2, "content": "SHARED", "priority": 4 },
+			{ "id": 201, "project_id": 2, "content": "B", "priority": 2, "due": { "date": "2026-10-20", "string": "Oct 20" } }
+		]`)
+		mockPostForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks", map[string]interface{}{"content": "A", "project_id": Int(2), "section_id": Int(20), "priority": Int(1)}, http.StatusOK, `{ "id": 300, "project_id": 2, "section_id": 20, "content": "A", "priority": 1 }`)
+		mockPostForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks/200", map[string]interface{}{"content": String("SHARED"), "description": String(""), "priority": Int(1), "due_string": String("no date")}, http.StatusNoContent, "")
+		mockPostForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks", map[string]interface{}{"content": "B", "project_id": Int(1), "priority": Int(2), "due_date": String("2026-10-20")}, http.StatusOK, `{ "id": 102, "project_id": 1, "content": "B", "priority": 2 }`)
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/comments?task_id=100", `[{ "id": 1000, "task_id": 100, "content": "NOTE" }]`)
+		mockPostForTest(dstAPI, "https://api.todoist.com/rest/v1/comments", map[string]interface{}{"task_id": 300, "content": "NOTE"}, http.StatusOK, `{ "id": 3000, "task_id": 300, "content": "NOTE" }`)
+
+		s, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: statePath, Strategy: SyncPreferSource})
+		assert.NoError(t, err)
+		res, err := s.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, &ProjectSyncResult{
+			ToTarget:  SyncCounts{Created: 1, Updated: 1, Comments: 1},
+			ToSource:  SyncCounts{Created: 1},
+			Conflicts: 1,
+		}, res)
+		srcAPI.AssertExpectations(t)
+		dstAPI.AssertExpectations(t)
+
+		restored, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: statePath})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, restored.state.TargetProjectID)
+		assert.Equal(t, map[int]int{10: 20}, restored.state.Sections)
+		assert.Equal(t, map[int]int{1000: 3000}, restored.state.Comments)
+		assert.Equal(t, []*syncedTask{
+			{SourceID: 100, TargetID: 300, Base: &syncedFields{Content: "A", Priority: 1}},
+			{SourceID: 101, TargetID: 200, Base: &syncedFields{Content: "SHARED", Priority: 1}},
+			{SourceID: 102, TargetID: 201, Base: &syncedFields{Content: "B", Priority: 2, DueDate: "2026-10-20"}},
+		}, restored.state.Tasks)
+	})
+
+	t.Run("should propagate edits and completions since the last sync", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "sync.json")
+		writeProjectSyncStateForTest(t, statePath, projectSyncState{
+			Version: projectSyncVersion, SourceProjectID: 1, TargetProjectID: 2,
+			Sections: map[int]int{}, Comments: map[int]int{},
+			Tasks: []*syncedTask{
+				{SourceID: 100, TargetID: 300, Base: &syncedFields{Content: "A", Priority: 1}},
+				{SourceID: 101, TargetID: 200, Base: &syncedFields{Content: "SHARED", Priority: 1}},
+				{SourceID: 102, TargetID: 201, Base: &syncedFields{Content: "B", Priority: 2}},
+			},
+		})
+		src, srcAPI := newClientForTest()
+		dst, dstAPI := newClientForTest()
+
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/sections?project_id=1", `[]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/sections?project_id=2", `[]`)
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks?project_id=1", `[
+			{ "id": 100, "project_id": 1, "content": "A2", "priority": 1 },
+			{ "id": 101, "project_id": 1, "content": "SHARED", "priority": 1 }
+		]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks?project_id=2", `[
+			{ "id": 300, "project_id": 2, "content": "A", "priority": 1 },
+			{ "id": 200, "project_id": 2, "content": "SHARED", "priority": 3 },
+			{ "id": 201, "project_id": 2, "content": "B", "priority": 2 }
+		]`)
+		mockPostForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks/300", map[string]interface{}{"content": String("A2"), "description": String(""), "priority": Int(1), "due_string": String("no date")}, http.StatusNoContent, "")
+		mockPostForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks/101", map[string]interface{}{"content": String("SHARED"), "description": String(""), "priority": Int(3), "due_string": String("no date")}, http.StatusNoContent, "")
+		mockPostForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks/201/close", nil, http.StatusNoContent, "")
+
+		s, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: statePath})
+		assert.NoError(t, err)
+		res, err := s.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, &ProjectSyncResult{
+			ToTarget: SyncCounts{Updated: 1, Completed: 1},
+			ToSource: SyncCounts{Updated: 1},
+		}, res)
+		assert.Len(t, s.state.Tasks, 2)
+		srcAPI.AssertExpectations(t)
+		dstAPI.AssertExpectations(t)
+	})
+
+	t.Run("should not update tasks due at the same datetime on different dates", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "sync.json")
+		writeProjectSyncStateForTest(t, statePath, projectSyncState{
+			Version: projectSyncVersion, SourceProjectID: 1, TargetProjectID: 2,
+			Sections: map[int]int{}, Comments: map[int]int{},
+			Tasks: []*syncedTask{
+				{SourceID: 100, TargetID: 300, Base: &syncedFields{Content: "A", Priority: 1, DueDatetime: "2026-10-20T23:00:00Z"}},
+			},
+		})
+		src, srcAPI := newClientForTest()
+		dst, dstAPI := newClientForTest()
+
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/sections?project_id=1", `[]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/sections?project_id=2", `[]`)
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks?project_id=1", `[
+			{ "id": 100, "project_id": 1, "content": "A", "priority": 1, "due": { "date": "2026-10-20", "datetime": "2026-10-20T23:00:00Z", "string": "Oct 20 11pm" } }
+		]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks?project_id=2", `[
+			{ "id": 300, "project_id": 2, "content": "A", "priority": 1, "due": { "date": "2026-10-21", "datetime": "2026-10-20T23:00:00Z", "string": "Oct 21 8am" } }
+		]`)
+
+		s, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: statePath})
+		assert.NoError(t, err)
+		res, err := s.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, &ProjectSyncResult{}, res)
+		srcAPI.AssertExpectations(t)
+		dstAPI.AssertExpectations(t)
+	})
+
+	t.Run("should resolve conflicts by the last writer", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "sync.json")
+		writeProjectSyncStateForTest(t, statePath, projectSyncState{
+			Version: projectSyncVersion, SourceProjectID: 1, TargetProjectID: 2,
+			Tasks: []*syncedTask{{SourceID: 100, TargetID: 300, Base: &syncedFields{Content: "X", Priority: 1}}},
+		})
+		src, srcAPI := newClientForTest()
+		dst, dstAPI := newClientForTest()
+
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/sections?project_id=1", `[]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/sections?project_id=2", `[]`)
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks?project_id=1", `[{ "id": 100, "project_id": 1, "content": "FROM SOURCE", "priority": 1 }]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks?project_id=2", `[{ "id": 300, "project_id": 2, "content": "FROM TARGET", "priority": 1 }]`)
+		activity := func(id int) map[string]interface{} {
+			return map[string]interface{}{"limit": 1, "offset": 0, "object_type": ActivityObjectTask, "object_id": id, "event_type": ActivityEventUpdated}
+		}
+		srcAPI.On("Do", newActivityRequestForTest(activity(100))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "count": 1, "events": [{ "id": 1, "object_type": "item", "object_id": 100, "event_type": "updated", "event_date": "2026-10-18T10:00:00Z" }] }`),
+		}, nil)
+		dstAPI.On("Do", newActivityRequestForTest(activity(300))).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "count": 1, "events": [{ "id": 2, "object_type": "item", "object_id": 300, "event_type": "updated", "event_date": "2026-10-18T11:00:00Z" }] }`),
+		}, nil)
+		mockPostForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks/100", map[string]interface{}{"content": String("FROM TARGET"), "description": String(""), "priority": Int(1), "due_string": String("no date")}, http.StatusNoContent, "")
+
+		s, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: statePath, Strategy: SyncLastWriterWins})
+		assert.NoError(t, err)
+		res, err := s.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, &ProjectSyncResult{ToSource: SyncCounts{Updated: 1}, Conflicts: 1}, res)
+		assert.Equal(t, &syncedFields{Content: "FROM TARGET", Priority: 1}, s.state.Tasks[0].Base)
+		srcAPI.AssertExpectations(t)
+		dstAPI.AssertExpectations(t)
+	})
+
+	t.Run("should let the source win a conflict if the activity log can't be read", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "sync.json")
+		writeProjectSyncStateForTest(t, statePath, projectSyncState{
+			Version: projectSyncVersion, SourceProjectID: 1, TargetProjectID: 2,
+			Tasks: []*syncedTask{{SourceID: 100, TargetID: 300, Base: &syncedFields{Content: "X", Priority: 1}}},
+		})
+		src, srcAPI := newClientForTest()
+		dst, dstAPI := newClientForTest()
+
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/sections?project_id=1", `[]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/sections?project_id=2", `[]`)
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/tasks?project_id=1", `[{ "id": 100, "project_id": 1, "content": "FROM SOURCE", "priority": 1 }]`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks?project_id=2", `[{ "id": 300, "project_id": 2, "content": "FROM TARGET", "priority": 1 }]`)
+		srcAPI.On("Do", newActivityRequestForTest(map[string]interface{}{"limit": 1, "offset": 0, "object_type": ActivityObjectTask, "object_id": 100, "event_type": ActivityEventUpdated})).Return(&restResponse{
+			StatusCode: http.StatusForbidden,
+			Body:       strings.NewReader("ERROR_RESPONSE"),
+		}, nil)
+		mockPostForTest(dstAPI, "https://api.todoist.com/rest/v1/tasks/300", map[string]interface{}{"content": String("FROM SOURCE"), "description": String(""), "priority": Int(1), "due_string": String("no date")}, http.StatusNoContent, "")
+
+		s, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: statePath})
+		assert.NoError(t, err)
+		res, err := s.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, &ProjectSyncResult{ToTarget: SyncCounts{Updated: 1}, Conflicts: 1}, res)
+		srcAPI.AssertExpectations(t)
+		dstAPI.AssertExpectations(t)
+	})
+
+	t.Run("should return an error if no project matches", func(t *testing.T) {
+		src, srcAPI := newClientForTest()
+		dst, dstAPI := newClientForTest()
+		mockGetForTest(srcAPI, "https://api.todoist.com/rest/v1/projects/1", `{ "id": 1, "name": "CLIENT", "sync_id": 0 }`)
+		mockGetForTest(dstAPI, "https://api.todoist.com/rest/v1/projects", `[{ "id": 2, "name": "OTHER", "sync_id": 0 }]`)
+
+		s, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: filepath.Join(t.TempDir(), "sync.json")})
+		assert.NoError(t, err)
+		_, err = s.Run(context.Background())
+
+		assert.ErrorIs(t, err, ErrProjectNotMatched)
+		srcAPI.AssertExpectations(t)
+		dstAPI.AssertExpectations(t)
+	})
+}
+
+func TestNewProjectSyncer(t *testing.T) {
+	t.Run("should return an error if the state is for another project", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "sync.json")
+		writeProjectSyncStateForTest(t, statePath, projectSyncState{Version: projectSyncVersion, SourceProjectID: 5})
+		src, _ := newClientForTest()
+		dst, _ := newClientForTest()
+
+		_, err := NewProjectSyncer(src, dst, ProjectSyncOptions{SourceProjectID: 1, StatePath: statePath})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,460 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Type of queued writes.
+type WriteOp string
+
+const (
+	WriteCreateTask        WriteOp = "create_task"
+	WriteUpdateTask        WriteOp = "update_task"
+	WriteCloseTask         WriteOp = "close_task"
+	WriteReopenTask        WriteOp = "reopen_task"
+	WriteDeleteTask        WriteOp = "delete_task"
+	WriteCreateTaskComment WriteOp = "create_task_comment"
+)
+
+var (
+	// Returned when a queued write refers to a temporary ID whose create failed or was discarded.
+	ErrUnresolvedTempID = errors.New("unresolved temporary ID")
+	// Returned when a queued write is not found.
+	ErrWriteNotFound = errors.New("queued write not found")
+	// Returned when a queued write has an unknown type or options that can't be decoded.
+	ErrInvalidWrite = errors.New("invalid queued write")
+)
+
+// Write queued by an OfflineQueue.
+type QueuedWrite struct {
+	// Write ID, which is also sent as the request ID so that a replayed write is not applied twice.
+	ID string `json:"id"`
+	// Type of the write.
+	Op WriteOp `json:"op"`
+	// ID of the task to write (for all writes except WriteCreateTask).
+	// It may be a temporary ID.
+	TaskID int `json:"task_id,omitempty"`
+	// Temporary ID of the created object (for WriteCreateTask and WriteCreateTaskComment).
+	TempID int `json:"temp_id,omitempty"`
+	// Content of the created task or comment.
+	Content string `json:"content,omitempty"`
+	// Options of the write as JSON.
+	Options json.RawMessage `json:"options,omitempty"`
+	// Date and time when the write was queued.
+	QueuedAt time.Time `json:"queued_at"`
+	// Error message of the permanent failure (only for failed writes).
+	Error string `json:"error,omitempty"`
+	// Response status code of the permanent failure, or 0 if the failure is not a request error.
+	StatusCode int `json:"status_code,omitempty"`
+}
+
+// Result of replaying queued writes.
+type ReplayResult struct {
+	// Writes applied successfully.
+	Done []*QueuedWrite
+	// Writes failed permanently and moved to the failed list.
+	Failed []*QueuedWrite
+}
+
+// Queue of writes persisted to a file and replayed in order when connectivity returns.
+// Created objects get negative temporary IDs, which can be used in later writes until they are resolved.
+type OfflineQueue struct {
+	cl   *Client
+	path string
+	now  func() time.Time
+
+	mu    sync.Mutex
+	state offlineQueueState
+}
+
+// Persisted state of an offline queue.
+type offlineQueueState struct {
+	NextTempID int            `json:"next_temp_id"`
+	Pending    []*QueuedWrite `json:"pending"`
+	Failed     []*QueuedWrite `json:"failed"`
+	// Mapping from temporary IDs to real IDs.
+	IDs map[int]int `json:"ids"`
+}
+
+// Returns new offline queue persisted to a file.
+// If the file exists, the queue is restored from it.
+func NewOfflineQueue(cl *Client, path string) (*OfflineQueue, error) {
+	q := &OfflineQueue{
+		cl:    cl,
+		path:  path,
+		now:   time.Now,
+		state: offlineQueueState{NextTempID: -1, Pending: []*QueuedWrite{}, Failed: []*QueuedWrite{}, IDs: map[int]int{}},
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return q, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &q.state); err != nil {
+		return nil, fmt.Errorf("invalid offline queue file: %w", err)
+	}
+	if q.state.IDs == nil {
+		q.state.IDs = map[int]int{}
+	}
+
+	return q, nil
+}
+
+// Queues creating a task and returns its temporary ID.
+// ProjectID, SectionID and ParentID of the options may be temporary IDs.
+func (q *OfflineQueue) CreateTask(content string, opts *CreateTaskOptions) (int, error) {
+	var reqID *string
+	if opts != nil {
+		reqID = opts.RequestID
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tempID := q.state.NextTempID
+	if err := q.enqueue(&QueuedWrite{Op: WriteCreateTask, TempID: tempID, Content: content}, reqID, opts); err != nil {
+		return 0, err
+	}
+	return tempID, nil
+}
+
+// Queues updating a task.
+func (q *OfflineQueue) UpdateTask(id int, opts *UpdateTaskOptions) error {
+	var reqID *string
+	if opts != nil {
+		reqID = opts.RequestID
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.enqueue(&QueuedWrite{Op: WriteUpdateTask, TaskID: id}, reqID, opts)
+}
+
+// Queues closing a task.
+func (q *OfflineQueue) CloseTask(id int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.enqueue(&QueuedWrite{Op: WriteCloseTask, TaskID: id}, nil, nil)
+}
+
+// Queues reopening a task.
+func (q *OfflineQueue) ReopenTask(id int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.enqueue(&QueuedWrite{Op: WriteReopenTask, TaskID: id}, nil, nil)
+}
+
+// Queues deleting a task.
+func (q *OfflineQueue) DeleteTask(id int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.enqueue(&QueuedWrite{Op: WriteDeleteTask, TaskID: id}, nil, nil)
+}
+
+// Queues creating a task comment and returns its temporary ID.
+func (q *OfflineQueue) CreateTaskComment(taskID int, content string, opts *CreateTaskCommentOptions) (int, error) {
+	var reqID *string
+	if opts != nil {
+		reqID = opts.RequestID
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tempID := q.state.NextTempID
+	if err := q.enqueue(&QueuedWrite{Op: WriteCreateTaskComment, TaskID: taskID, TempID: tempID, Content: content}, reqID, opts); err != nil {
+		return 0, err
+	}
+	return tempID, nil
+}
+
+// Returns the real ID of a temporary ID if its create has been replayed.
+// Non-negative IDs are returned as is.
+func (q *OfflineQueue) ResolveID(id int) (int, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.resolve(id)
+}
+
+// Returns the writes waiting to be replayed, in order.
+func (q *OfflineQueue) Pending() []*QueuedWrite {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]*QueuedWrite{}, q.state.Pending...)
+}
+
+// Returns the writes that failed permanently.
+func (q *OfflineQueue) Failed() []*QueuedWrite {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]*QueuedWrite{}, q.state.Failed...)
+}
+
+// Moves a failed write back to the end of the pending writes.
+func (q *OfflineQueue) Requeue(writeID string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	w, failed, err := q.removeFailed(writeID)
+	if err != nil {
+		return err
+	}
+	requeued := *w
+	requeued.Error = ""
+	requeued.StatusCode = 0
+
+	next := q.state
+	next.Failed = failed
+	next.Pending = append(append([]*QueuedWrite{}, q.state.Pending...), &requeued)
+	return q.commit(next)
+}
+
+// Removes a failed write.
+func (q *OfflineQueue) Discard(writeID string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	_, failed, err := q.removeFailed(writeID)
+	if err != nil {
+		return err
+	}
+
+	next := q.state
+	next.Failed = failed
+	return q.commit(next)
+}
+
+// Replays the pending writes in order.
+// Writes failing permanently (e.g. 4xx responses) are moved to the failed list and replaying continues.
+// On a transient failure (e.g. network errors, 5xx or 429 responses), replaying stops and the error is returned,
+// leaving the failed write and the rest pending for the next replay.
+// Queueing writes blocks until replaying finishes.
+func (q *OfflineQueue) Replay(ctx context.Context) (*ReplayResult, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	c := q.cl.WithContext(ctx)
+	res := &ReplayResult{Done: []*QueuedWrite{}, Failed: []*QueuedWrite{}}
+	for len(q.state.Pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		w := q.state.Pending[0]
+		id, err := q.apply(c, w)
+		if err != nil && !isPermanentError(err) {
+			return res, err
+		}
+
+		next := q.state
+		next.Pending = append([]*QueuedWrite{}, q.state.Pending[1:]...)
+		next.Failed = append([]*QueuedWrite{}, q.state.Failed...)
+		next.IDs = make(map[int]int, len(q.state.IDs)+1)
+		for tempID, realID := range q.state.IDs {
+			next.IDs[tempID] = realID
+		}
+		if err != nil {
+			failed := *w
+			failed.Error = err.Error()
+			if rerr, ok := err.(RequestError); ok {
+				failed.StatusCode = rerr.StatusCode
+			}
+			w = &failed
+			next.Failed = append(next.Failed, w)
+		} else if w.TempID != 0 {
+			next.IDs[w.TempID] = id
+		}
+		// if saving fails, the write stays pending and its request ID deduplicates the next replay.
+		if cerr := q.commit(next); cerr != nil {
+			return res, cerr
+		}
+
+		if err != nil {
+			res.Failed = append(res.Failed, w)
+		} else {
+			res.Done = append(res.Done, w)
+		}
+	}
+
+	return res, nil
+}
+
+// Applies a write with cl and returns the real ID of the object it creates, or 0 if it creates nothing.
+func (q *OfflineQueue) apply(cl *Client, w *QueuedWrite) (int, error) {
+	reqID := w.ID
+
+	var taskID int
+	if w.Op != WriteCreateTask {
+		id, ok := q.resolve(w.TaskID)
+		if !ok {
+			return 0, fmt.Errorf("%w: task %d", ErrUnresolvedTempID, w.TaskID)
+		}
+		taskID = id
+	}
+
+	switch w.Op {
+	case WriteCreateTask:
+		opts := &CreateTaskOptions{}
+		if err := q.decodeOptions(w, opts); err != nil {
+			return 0, err
+		}
+		for _, id := range []*int{opts.ProjectID, opts.SectionID, opts.ParentID} {
+			if id == nil {
+				continue
+			}
+			resolved, ok := q.resolve(*id)
+			if !ok {
+				return 0, fmt.Errorf("%w: %d", ErrUnresolvedTempID, *id)
+			}
+			*id = resolved
+		}
+		opts.RequestID = &reqID
+		task, err := cl.CreateTaskWithOptions(w.Content, opts)
+		if err != nil {
+			return 0, err
+		}
+		return task.ID, nil
+	case WriteUpdateTask:
+		opts := &UpdateTaskOptions{}
+		if err := q.decodeOptions(w, opts); err != nil {
+			return 0, err
+		}
+		opts.RequestID = &reqID
+		return 0, cl.UpdateTaskWithOptions(taskID, opts)
+	case WriteCloseTask:
+		return 0, cl.CloseTaskWithOptions(taskID, &CloseTaskOptions{RequestID: &reqID})
+	case WriteReopenTask:
+		return 0, cl.ReopenTaskWithOptions(taskID, &ReopenTaskOptions{RequestID: &reqID})
+	case WriteDeleteTask:
+		return 0, cl.DeleteTaskWithOptions(taskID, &DeleteTaskOptions{RequestID: &reqID})
+	case WriteCreateTaskComment:
+		opts := &CreateTaskCommentOptions{}
+		if err := q.decodeOptions(w, opts); err != nil {
+			return 0, err
+		}
+		opts.RequestID = &reqID
+		cmt, err := cl.CreateTaskCommentWithOptions(taskID, w.Content, opts)
+		if err != nil {
+			return 0, err
+		}
+		return cmt.ID, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown type: %s", ErrInvalidWrite, w.Op)
+	}
+}
+
+func (q *OfflineQueue) enqueue(w *QueuedWrite, reqID *string, opts interface{}) error {
+	if reqID != nil {
+		w.ID = *reqID
+	} else {
+		w.ID = q.cl.newUUID()
+	}
+	w.QueuedAt = q.now().UTC()
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+	if string(b) != "null" {
+		w.Options = b
+	}
+
+	next := q.state
+	if w.TempID != 0 {
+		next.NextTempID--
+	}
+	next.Pending = append(append([]*QueuedWrite{}, q.state.Pending...), w)
+	return q.commit(next)
+}
+
+func (q *OfflineQueue) decodeOptions(w *QueuedWrite, opts interface{}) error {
+	if len(w.Options) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(w.Options, opts); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWrite, err)
+	}
+	return nil
+}
+
+func (q *OfflineQueue) resolve(id int) (int, bool) {
+	if id >= 0 {
+		return id, true
+	}
+	realID, ok := q.state.IDs[id]
+	return realID, ok
+}
+
+// Returns a failed write and the rest of the failed writes without changing the state.
+func (q *OfflineQueue) removeFailed(writeID string) (*QueuedWrite, []*QueuedWrite, error) {
+	for i, w := range q.state.Failed {
+		if w.ID == writeID {
+			failed := append(append([]*QueuedWrite{}, q.state.Failed[:i]...), q.state.Failed[i+1:]...)
+			return w, failed, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w: %s", ErrWriteNotFound, writeID)
+}
+
+// Saves the next state and replaces the state with it only if saving succeeds.
+func (q *OfflineQueue) commit(next offlineQueueState) error {
+	if err := q.save(next); err != nil {
+		return err
+	}
+	q.state = next
+	return nil
+}
+
+// Writes a state to the file atomically.
+func (q *OfflineQueue) save(state offlineQueueState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(q.path), 0o700); err != nil {
+		return err
+	}
+	tmp := q.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, q.path)
+}
+
+// Returns whether an error will not be resolved by retrying.
+func isPermanentError(err error) bool {
+	if errors.Is(err, ErrUnresolvedTempID) || errors.Is(err, ErrInvalidWrite) {
+		return true
+	}
+	// the response was received but can't be decoded.
+	var serr *json.SyntaxError
+	var terr *json.UnmarshalTypeError
+	if errors.As(err, &serr) || errors.As(err, &terr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var rerr RequestError
+	if !errors.As(err, &rerr) {
+		return false
+	}
+	switch {
+	case rerr.StatusCode == http.StatusRequestTimeout, rerr.StatusCode == http.StatusTooManyRequests:
+		return false
+	default:
+		return 400 <= rerr.StatusCode && rerr.StatusCode < 500
+	}
+}
@@ -0,0 +1,215 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newQueuedCreateTaskRequestForTest(reqID string, payload map[string]interface{}) *restRequest {
+	return &restRequest{
+		Context: context.Background(),
+		URL:     "https://api.todoist.com/rest/v1/tasks",
+		Method:  http.MethodPost,
+		Payload: payload,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json", "X-Request-Id": reqID},
+	}
+}
+
+func newQueuedCloseTaskRequestForTest(reqID string, id int) *restRequest {
+	return &restRequest{
+		Context: context.Background(),
+		URL:     fmt.Sprintf("https://api.todoist.com/rest/v1/tasks/%d/close", id),
+		Method:  http.MethodPost,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN", "X-Request-Id": reqID},
+	}
+}
+
+func TestOfflineQueue_Replay(t *testing.T) {
+	t.Run("should replay writes in order resolving temporary IDs", func(t *testing.T) {
+		cl, api := newClientForTest()
+		q, err := NewOfflineQueue(cl, filepath.Join(t.TempDir(), "queue.json"))
+		assert.NoError(t, err)
+
+		parentID, err := q.CreateTask("PARENT", nil)
+		assert.NoError(t, err)
+		childID, err := q.CreateTask("CHILD", &CreateTaskOptions{ParentID: &parentID})
+		assert.NoError(t, err)
+		assert.NoError(t, q.CloseTask(childID))
+		assert.Equal(t, -1, parentID)
+		assert.Equal(t, -2, childID)
+
+		api.On("Do", newQueuedCreateTaskRequestForTest("UUID_1", map[string]interface{}{"content": "PARENT"})).
+			Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": 100, "content": "PARENT" }`)}, nil)
+		api.On("Do", newQueuedCreateTaskRequestForTest("UUID_2", map[string]interface{}{"content": "CHILD", "parent_id": Int(100)})).
+			Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": 200, "content": "CHILD" }`)}, nil)
+		api.On("Do", newQueuedCloseTaskRequestForTest("UUID_3", 200)).
+			Return(&restResponse{StatusCode: http.StatusNoContent, Body: strings.NewReader("")}, nil)
+
+		res, err := q.Replay(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Done, 3)
+		assert.Empty(t, res.Failed)
+		assert.Empty(t, q.Pending())
+		id, ok := q.ResolveID(childID)
+		assert.True(t, ok)
+		assert.Equal(t, 200, id)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should move permanent failures and dependent writes to the failed list", func(t *testing.T) {
+		cl, api := newClientForTest()
+		q, err := NewOfflineQueue(cl, filepath.Join(t.TempDir(), "queue.json"))
+		assert.NoError(t, err)
+
+		id, err := q.CreateTask("TASK", nil)
+		assert.NoError(t, err)
+		assert.NoError(t, q.CloseTask(id))
+
+		api.On("Do", newQueuedCreateTaskRequestForTest("UUID_1", map[string]interface{}{"content": "TASK"})).
+			Return(&restResponse{StatusCode: http.StatusBadRequest, Body: strings.NewReader("ERROR_RESPONSE")}, nil)
+
+		res, err := q.Replay(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Done)
+		assert.Len(t, res.Failed, 2)
+		assert.Equal(t, http.StatusBadRequest, res.Failed[0].StatusCode)
+		assert.Contains(t, res.Failed[1].Error, ErrUnresolvedTempID.Error())
+		assert.Len(t, q.Failed(), 2)
+
+		assert.NoError(t, q.Discard("UUID_2"))
+		assert.NoError(t, q.Requeue("UUID_1"))
+		assert.ErrorIs(t, q.Discard("UUID_2"), ErrWriteNotFound)
+		assert.Empty(t, q.Failed())
+		assert.Len(t, q.Pending(), 1)
+		assert.Empty(t, q.Pending()[0].Error)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should move invalid writes and undecodable responses to the failed list", func(t *testing.T) {
+		cl, api := newClientForTest()
+		q, err := NewOfflineQueue(cl, filepath.Join(t.TempDir(), "queue.json"))
+		assert.NoError(t, err)
+
+		assert.NoError(t, q.UpdateTask(1, nil))
+		q.state.Pending[0].Options = json.RawMessage(`{ "priority": "HIGH" }`)
+		q.state.Pending = append(q.state.Pending, &QueuedWrite{ID: "UNKNOWN", Op: "UNKNOWN", TaskID: 1})
+		_, err = q.CreateTask("TASK", nil)
+		assert.NoError(t, err)
+
+		api.On("Do", newQueuedCreateTaskRequestForTest("UUID_2", map[string]interface{}{"content": "TASK"})).
+			Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": "INVALID" }`)}, nil)
+
+		res, err := q.Replay(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Done)
+		assert.Len(t, res.Failed, 3)
+		assert.Empty(t, q.Pending())
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should stop and keep writes pending on a transient failure", func(t *testing.T) {
+		cl, api := newClientForTest()
+		q, err := NewOfflineQueue(cl, filepath.Join(t.TempDir(), "queue.json"))
+		assert.NoError(t, err)
+
+		assert.NoError(t, q.CloseTask(1))
+		assert.NoError(t, q.CloseTask(2))
+
+		api.On("Do", newQueuedCloseTaskRequestForTest("UUID_1", 1)).
+			Return(&restResponse{StatusCode: http.StatusServiceUnavailable, Body: strings.NewReader("ERROR_RESPONSE")}, nil)
+
+		res, err := q.Replay(context.Background())
+
+		assert.Error(t, err)
+		assert.IsType(t, RequestError{}, err)
+		assert.Empty(t, res.Done)
+		assert.Len(t, q.Pending(), 2)
+		assert.Empty(t, q.Failed())
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should keep the state unchanged if saving fails", func(t *testing.T) {
+		dir := t.TempDir()
+		cl, api := newClientForTest()
+		blocker := filepath.Join(dir, "blocker")
+		assert.NoError(t, os.WriteFile(blocker, nil, 0o600))
+		q, err := NewOfflineQueue(cl, filepath.Join(dir, "queue.json"))
+		assert.NoError(t, err)
+
+		id, err := q.CreateTask("TASK", nil)
+		assert.NoError(t, err)
+		assert.NoError(t, q.CloseTask(id))
+		q.path = filepath.Join(blocker, "queue.json")
+
+		api.On("Do", newQueuedCreateTaskRequestForTest("UUID_1", map[string]interface{}{"content": "TASK"})).
+			Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": 100, "content": "TASK" }`)}, nil)
+
+		res, err := q.Replay(context.Background())
+
+		assert.Error(t, err)
+		assert.Empty(t, res.Done)
+		assert.Len(t, q.Pending(), 2)
+		assert.Empty(t, q.Failed())
+		_, ok := q.ResolveID(id)
+		assert.False(t, ok)
+		api.AssertExpectations(t)
+	})
+}
+
+func TestOfflineQueue_enqueue(t *testing.T) {
+	t.Run("should not queue a write if saving fails", func(t *testing.T) {
+		dir := t.TempDir()
+		cl, _ := newClientForTest()
+		// the parent of the file is a file, so the queue can't be saved.
+		blocker := filepath.Join(dir, "blocker")
+		assert.NoError(t, os.WriteFile(blocker, nil, 0o600))
+		q, err := NewOfflineQueue(cl, filepath.Join(dir, "queue.json"))
+		assert.NoError(t, err)
+		q.path = filepath.Join(blocker, "queue.json")
+
+		_, err = q.CreateTask("TASK", nil)
+
+		assert.Error(t, err)
+		assert.Empty(t, q.Pending())
+		assert.Equal(t, -1, q.state.NextTempID)
+	})
+}
+
+func TestNewOfflineQueue(t *testing.T) {
+	t.Run("should restore the queue from the file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue", "queue.json")
+		cl, api := newClientForTest()
+		q, err := NewOfflineQueue(cl, path)
+		assert.NoError(t, err)
+		id, err := q.CreateTask("TASK", &CreateTaskOptions{RequestID: String("REQUEST_ID"), Priority: Int(4)})
+		assert.NoError(t, err)
+		assert.NoError(t, q.CloseTask(id))
+
+		restored, err := NewOfflineQueue(cl, path)
+		assert.NoError(t, err)
+		assert.Equal(t, q.Pending(), restored.Pending())
+
+		api.On("Do", newQueuedCreateTaskRequestForTest("REQUEST_ID", map[string]interface{}{"content": "TASK", "priority": Int(4)})).
+			Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": 100, "content": "TASK" }`)}, nil)
+		api.On("Do", newQueuedCloseTaskRequestForTest("UUID_1", 100)).
+			Return(&restResponse{StatusCode: http.StatusNoContent, Body: strings.NewReader("")}, nil)
+
+		_, err = restored.Replay(context.Background())
+		assert.NoError(t, err)
+		next, err := restored.CreateTask("NEXT", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, -2, next)
+		api.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,172 @@
+package todoist
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Options for quick adding a task.
+type QuickAddOptions struct {
+	// Content of a note to add to the task.
+	Note *string `json:"note,omitempty"`
+	// Date of a reminder to add to the task in free form text.
+	Reminder *string `json:"reminder,omitempty"`
+	// Whether to add the default reminder to the task if it has a due date with time.
+	AutoReminder *bool `json:"auto_reminder,omitempty"`
+}
+
+// Creates a task from natural language text (e.g. "Pay rent tomorrow 9am #Home @finance p1") and returns it.
+// Project, section, labels, priority and due date are parsed by Todoist.
+func (cl *Client) QuickAddTask(text string) (*Task, error) {
+	return cl.QuickAddTaskWithOptions(text, nil)
+}
+
+// Creates a task from natural language text with options and returns it.
+func (cl *Client) QuickAddTaskWithOptions(text string, opts *QuickAddOptions) (*Task, error) {
+	p := map[string]interface{}{"text": text}
+	if err := toMap(opts, p); err != nil {
+		return nil, err
+	}
+
+	stask := syncTask{}
+	if err := cl.syncPost("/v8/quick/add", p, &stask); err != nil {
+		return nil, err
+	}
+
+	return stask.task(), nil
+}
+
+// Result of parsing quick add text offline.
+type QuickAddPreview struct {
+	// Task content without the parsed tokens.
+	Content string
+	// Project name (#Project).
+	Project string
+	// Section name (/Section).
+	Section string
+	// Label names (@label).
+	Labels []string
+	// Task priority from 1 (normal) to 4 (urgent), parsed from p4 to p1.
+	// 0 if not set.
+	Priority int
+	// Due date expression (e.g. "tomorrow 9am").
+	DueString string
+}
+
+var (
+	quickAddPriorityRe = regexp.MustCompile(`^[pP]([1-4])$`)
+	quickAddTimeRe     = regexp.MustCompile(`^(\d{1,2}(:\d{2})?(am|pm)|\d{1,2}:\d{2})$`)
+	quickAddNumberRe   = regexp.MustCompile(`^\d+(st|nd|rd|th)?$`)
+	quickAddDateRe     = regexp.MustCompile(`^\d{1,4}[/\-.]\d{1,2}([/\-.]\d{1,4})?$`)
+)
+
+var (
+	// Words that are due dates by themselves.
+	quickAddDateWords = toSet("today", "tod", "tomorrow", "tmr", "tonight", "noon", "midnight", "morning", "afternoon", "evening", "weekday", "weekdays", "weekend", "daily", "weekly", "monthly", "yearly",
+		"mon", "monday", "tue", "tues", "tuesday", "wed", "wednesday", "thu", "thur", "thurs", "thursday", "fri", "friday", "sat", "saturday", "sun", "sunday")
+	// Month names, which are due dates by themselves and make adjacent numbers due dates.
+	quickAddMonthWords = toSet("jan", "january", "feb", "february", "mar", "march", "apr", "april", "may", "jun", "june", "jul", "july", "aug", "august", "sep", "sept", "september", "oct", "october", "nov", "november", "dec", "december")
+	// Time units, which are due dates only after a number or a connector.
+	quickAddUnitWords = toSet("day", "days", "week", "weeks", "month", "months", "year", "years", "hour", "hours", "min", "mins", "minute", "minutes")
+	// Words that are due dates only before another due date word.
+	quickAddConnectorWords = toSet("at", "on", "in", "next", "this", "every", "every!", "other", "starting", "from", "until", "end", "of", "and", ",")
+)
+
+// Parses quick add text offline to preview the project, section, labels, priority and due date.
+// Due dates are detected heuristically and may differ from how Todoist parses them.
+func ParseQuickAdd(text string) *QuickAddPreview {
+	pv := &QuickAddPreview{Labels: []string{}}
+
+	words := []string{}
+	for _, w := range strings.Fields(text) {
+		switch {
+		case len(w) > 1 && w[0] == '#':
+			pv.Project = w[1:]
+		case len(w) > 1 && w[0] == '/' && !strings.Contains(w[1:], "/"):
+			pv.Section = w[1:]
+		case len(w) > 1 && w[0] == '@':
+			pv.Labels = append(pv.Labels, w[1:])
+		case quickAddPriorityRe.MatchString(w):
+			pv.Priority = 5 - int(w[1]-'0')
+		default:
+			words = append(words, w)
+		}
+	}
+
+	isDue := quickAddDueWords(words)
+	content := []string{}
+	due := []string{}
+	for i, w := range words {
+		if isDue[i] {
+			due = append(due, w)
+		} else {
+			content = append(content, w)
+		}
+	}
+	pv.Content = strings.Join(content, " ")
+	pv.DueString = strings.Join(due, " ")
+
+	return pv
+}
+
+// Returns whether each word is a part of the due date.
+func quickAddDueWords(words []string) []bool {
+	norm := make([]string, len(words))
+	for i, w := range words {
+		norm[i] = strings.Trim(strings.ToLower(w), ".,")
+	}
+	kind := func(i int) string {
+		if i < 0 || len(norm) <= i {
+			return ""
+		}
+		w := norm[i]
+		switch {
+		case quickAddMonthWords[w]:
+			return "month"
+		case quickAddDateWords[w], quickAddTimeRe.MatchString(w), quickAddDateRe.MatchString(w):
+			return "date"
+		case quickAddUnitWords[w]:
+			return "unit"
+		case quickAddNumberRe.MatchString(w):
+			return "number"
+		case quickAddConnectorWords[w]:
+			return "connector"
+		}
+		return ""
+	}
+
+	isDue := make([]bool, len(words))
+	for i := range words {
+		switch kind(i) {
+		case "date", "month":
+			isDue[i] = true
+		case "unit":
+			// e.g. "3 days", "every week", "next month"
+			isDue[i] = kind(i-1) == "number" || kind(i-1) == "connector"
+		}
+	}
+	for i := range words {
+		if kind(i) != "number" {
+			continue
+		}
+		// e.g. "jan 5", "5th jan", "3 days", "every 3rd friday"
+		next := kind(i + 1)
+		isDue[i] = kind(i-1) == "month" || next == "month" || (next == "unit" && isDue[i+1]) || (next == "date" && kind(i-1) == "connector")
+	}
+	// connectors are due dates if followed by a due date word, e.g. "every other week", "at 9am".
+	for i := len(words) - 1; i >= 0; i-- {
+		if kind(i) == "connector" && i+1 < len(words) && isDue[i+1] {
+			isDue[i] = true
+		}
+	}
+
+	return isDue
+}
+
+func toSet(ss ...string) map[string]bool {
+	m := map[string]bool{}
+	for _, s := range ss {
+		m[s] = true
+	}
+	return m
+}
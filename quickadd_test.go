@@ -0,0 +1,112 @@
+package todoist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_QuickAddTaskWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *QuickAddOptions
+		payload map[string]interface{}
+		resp    *restResponse
+		want    *Task
+		wantErr bool
+	}{
+		{
+			name:    "should return a task",
+			opts:    &QuickAddOptions{Note: String("NOTE"), AutoReminder: Bool(true)},
+			payload: map[string]interface{}{"text": "Pay rent tomorrow 9am #Home @finance p1", "note": String("NOTE"), "auto_reminder": Bool(true)},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body: strings.NewReader(`{
+					"id": 1, "project_id": 2, "section_id": null, "content": "Pay rent", "description": "", "checked": 0,
+					"labels": [3], "parent_id": null, "child_order": 4, "priority": 4, "responsible_uid": null,
+					"due": { "date": "2022-01-02T09:00:00", "timezone": null, "string": "tomorrow 9am", "lang": "en", "is_recurring": false }
+				}`),
+			},
+			want: &Task{
+				ID:        1,
+				ProjectID: 2,
+				Content:   "Pay rent",
+				LabelIDs:  []int{3},
+				Order:     4,
+				Priority:  4,
+				URL:       "https://todoist.com/showTask?id=1",
+				Due:       &Due{String: "tomorrow 9am", Date: "2022-01-02", LocalDatetime: String("2022-01-02T09:00:00")},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error if the request fails",
+			opts:    nil,
+			payload: map[string]interface{}{"text": "Pay rent tomorrow 9am #Home @finance p1"},
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", &restRequest{
+				URL:     "https://api.todoist.com/sync/v8/quick/add",
+				Method:  http.MethodPost,
+				Payload: tt.payload,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+			}).Return(tt.resp, nil)
+
+			task, err := cl.QuickAddTaskWithOptions("Pay rent tomorrow 9am #Home @finance p1", tt.opts)
+
+			assert.Equal(t, tt.want, task)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestParseQuickAdd(t *testing.T) {
+	tests := []struct {
+		text string
+		want *QuickAddPreview
+	}{
+		{
+			text: "Pay rent tomorrow 9am #Home @finance p1",
+			want: &QuickAddPreview{Content: "Pay rent", Project: "Home", Labels: []string{"finance"}, Priority: 4, DueString: "tomorrow 9am"},
+		},
+		{
+			text: "Review PR /Backlog #Work @code @review every other week p3",
+			want: &QuickAddPreview{Content: "Review PR", Project: "Work", Section: "Backlog", Labels: []string{"code", "review"}, Priority: 2, DueString: "every other week"},
+		},
+		{
+			text: "Buy 2 apples on jan 5 at 17:00",
+			want: &QuickAddPreview{Content: "Buy 2 apples", Labels: []string{}, DueString: "on jan 5 at 17:00"},
+		},
+		{
+			text: "Standup every 3rd friday",
+			want: &QuickAddPreview{Content: "Standup", Labels: []string{}, DueString: "every 3rd friday"},
+		},
+		{
+			text: "Read https://example.com/a/b in 3 days",
+			want: &QuickAddPreview{Content: "Read https://example.com/a/b", Labels: []string{}, DueString: "in 3 days"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseQuickAdd(tt.text))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package todoist
+
+import (
+	"sync"
+	"time"
+)
+
+type rateLimitAPI struct {
+	restAPI  restAPI
+	interval time.Duration
+	now      func() time.Time
+	sleep    func(time.Duration)
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+var _ restAPI = (*rateLimitAPI)(nil)
+
+func newRateLimitAPI(api restAPI, rps float64) *rateLimitAPI {
+	return &rateLimitAPI{
+		restAPI:  api,
+		interval: time.Duration(float64(time.Second) / rps),
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+func (api *rateLimitAPI) Do(req *restRequest) (*restResponse, error) {
+	api.sleep(api.reserve())
+	return api.restAPI.Do(req)
+}
+
+// Reserves a slot for a request and returns the duration to wait for it.
+func (api *rateLimitAPI) reserve() time.Duration {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	now := api.now()
+	if api.next.Before(now) {
+		api.next = now
+	}
+	wait := api.next.Sub(now)
+	api.next = api.next.Add(api.interval)
+
+	return wait
+}
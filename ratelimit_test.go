@@ -0,0 +1,38 @@
+package todoist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitAPI_Do(t *testing.T) {
+	t.Run("should space requests by the interval", func(t *testing.T) {
+		mapi := &mockRestAPI{}
+		now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		waits := []time.Duration{}
+
+		api := newRateLimitAPI(mapi, 2)
+		api.now = func() time.Time { return now }
+		api.sleep = func(d time.Duration) { waits = append(waits, d) }
+
+		req := &restRequest{URL: "https://api.todoist.com/rest/v1/tasks", Method: http.MethodGet}
+		mapi.On("Do", req).Return(func(*restRequest) *restResponse {
+			return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader("[]")}
+		}, nil).Times(4)
+
+		for i := 0; i < 3; i++ {
+			_, err := api.Do(req)
+			assert.NoError(t, err)
+		}
+		now = now.Add(5 * time.Second)
+		_, err := api.Do(req)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []time.Duration{0, 500 * time.Millisecond, time.Second, 0}, waits)
+		mapi.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,228 @@
+package todoist
+
+import (
+	"fmt"
+)
+
+// Type of reminders.
+type ReminderType string
+
+const (
+	// Reminder relative to the due date of the task.
+	ReminderTypeRelative ReminderType = "relative"
+	// Reminder at a specific date and time.
+	ReminderTypeAbsolute ReminderType = "absolute"
+	// Reminder triggered by a location.
+	ReminderTypeLocation ReminderType = "location"
+)
+
+type Reminder struct {
+	// Reminder ID.
+	ID int `json:"id"`
+	// ID of the task the reminder is for.
+	TaskID int `json:"item_id"`
+	// ID of the user to be notified.
+	NotifyUID int `json:"notify_uid"`
+	// Type of the reminder.
+	Type ReminderType `json:"type"`
+	// Date and time of the reminder (for absolute reminders).
+	Due *Due `json:"due"`
+	// Minutes before the due date of the task (for relative reminders).
+	MinuteOffset *int `json:"minute_offset"`
+	// Name of the location (for location reminders).
+	Name *string `json:"name"`
+	// Location latitude (for location reminders).
+	LocLat *string `json:"loc_lat"`
+	// Location longitude (for location reminders).
+	LocLong *string `json:"loc_long"`
+	// Whether to trigger the reminder when entering ("on_enter") or leaving ("on_leave") the location (for location reminders).
+	LocTrigger *string `json:"loc_trigger"`
+	// Radius around the location in meters (for location reminders).
+	Radius *int `json:"radius"`
+}
+
+// List of reminders.
+type Reminders []*Reminder
+
+// Due date and time of a reminder.
+type ReminderDue struct {
+	// Date and time in RFC3339 (https://www.ietf.org/rfc/rfc3339.txt) format in UTC, or in local time without offset.
+	Date *string `json:"date,omitempty"`
+	// Timezone of the date and time.
+	Timezone *string `json:"timezone,omitempty"`
+	// Human defined (https://todoist.com/help/articles/due-dates-and-times) date and time.
+	String *string `json:"string,omitempty"`
+	// 2-letter code specifying language in case String is not written in English.
+	Lang *string `json:"lang,omitempty"`
+}
+
+// Options for getting reminders.
+type GetRemindersOptions struct {
+	// Filter reminders by task ID.
+	TaskID *int
+}
+
+// Gets list of all reminders.
+func (cl *Client) GetReminders() (Reminders, error) {
+	return cl.GetRemindersWithOptions(nil)
+}
+
+// Gets list of all reminders with options.
+func (cl *Client) GetRemindersWithOptions(opts *GetRemindersOptions) (Reminders, error) {
+	out := struct {
+		Reminders []*syncReminder `json:"reminders"`
+	}{}
+	if _, err := cl.syncRead("*", []string{"reminders"}, &out); err != nil {
+		return nil, err
+	}
+
+	rems := Reminders{}
+	for _, srem := range out.Reminders {
+		if srem.IsDeleted {
+			continue
+		}
+		if opts != nil && opts.TaskID != nil && srem.ItemID != *opts.TaskID {
+			continue
+		}
+		rems = append(rems, srem.reminder())
+	}
+
+	return rems, nil
+}
+
+// Gets a reminder.
+func (cl *Client) GetReminder(id int) (*Reminder, error) {
+	rems, err := cl.GetReminders()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rem := range rems {
+		if rem.ID == id {
+			return rem, nil
+		}
+	}
+
+	return nil, fmt.Errorf("reminder not found: %d", id)
+}
+
+// Options for creating a reminder.
+type CreateReminderOptions struct {
+	// Type of the reminder.
+	// Default is ReminderTypeRelative for tasks with a due time and ReminderTypeAbsolute otherwise.
+	Type *ReminderType `json:"type,omitempty"`
+	// ID of the user to be notified.
+	// Default is the current user.
+	NotifyUID *int `json:"notify_uid,omitempty"`
+	// Date and time of the reminder (for absolute reminders).
+	Due *ReminderDue `json:"due,omitempty"`
+	// Minutes before the due date of the task (for relative reminders).
+	MinuteOffset *int `json:"mm_offset,omitempty"`
+	// Name of the location (for location reminders).
+	Name *string `json:"name,omitempty"`
+	// Location latitude (for location reminders).
+	LocLat *string `json:"loc_lat,omitempty"`
+	// Location longitude (for location reminders).
+	LocLong *string `json:"loc_long,omitempty"`
+	// Whether to trigger the reminder when entering ("on_enter") or leaving ("on_leave") the location (for location reminders).
+	LocTrigger *string `json:"loc_trigger,omitempty"`
+	// Radius around the location in meters (for location reminders).
+	Radius *int `json:"radius,omitempty"`
+}
+
+// Creates a reminder for a task and returns it.
+func (cl *Client) CreateReminder(taskID int, opts *CreateReminderOptions) (*Reminder, error) {
+	args := map[string]interface{}{"item_id": taskID}
+	if err := toMap(opts, args); err != nil {
+		return nil, err
+	}
+
+	cmd := cl.newCreateCommand("reminder_add", args)
+	resp, err := cl.syncCommands(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetReminder(resp.TempIDMapping[cmd.TempID])
+}
+
+// Options for updating a reminder.
+type UpdateReminderOptions struct {
+	// Type of the reminder.
+	Type *ReminderType `json:"type,omitempty"`
+	// ID of the user to be notified.
+	NotifyUID *int `json:"notify_uid,omitempty"`
+	// Date and time of the reminder (for absolute reminders).
+	Due *ReminderDue `json:"due,omitempty"`
+	// Minutes before the due date of the task (for relative reminders).
+	MinuteOffset *int `json:"mm_offset,omitempty"`
+	// Name of the location (for location reminders).
+	Name *string `json:"name,omitempty"`
+	// Location latitude (for location reminders).
+	LocLat *string `json:"loc_lat,omitempty"`
+	// Location longitude (for location reminders).
+	LocLong *string `json:"loc_long,omitempty"`
+	// Whether to trigger the reminder when entering ("on_enter") or leaving ("on_leave") the location (for location reminders).
+	LocTrigger *string `json:"loc_trigger,omitempty"`
+	// Radius around the location in meters (for location reminders).
+	Radius *int `json:"radius,omitempty"`
+}
+
+// Updates a reminder.
+func (cl *Client) UpdateReminder(id int, opts *UpdateReminderOptions) error {
+	args := map[string]interface{}{"id": id}
+	if err := toMap(opts, args); err != nil {
+		return err
+	}
+
+	if _, err := cl.syncCommands(cl.newCommand("reminder_update", args)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Deletes a reminder.
+func (cl *Client) DeleteReminder(id int) error {
+	if _, err := cl.syncCommands(cl.newCommand("reminder_delete", map[string]interface{}{"id": id})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reminder object of the Sync API.
+type syncReminder struct {
+	ID         int          `json:"id"`
+	ItemID     int          `json:"item_id"`
+	NotifyUID  int          `json:"notify_uid"`
+	Type       ReminderType `json:"type"`
+	Due        *syncDue     `json:"due"`
+	MmOffset   *int         `json:"mm_offset"`
+	Name       *string      `json:"name"`
+	LocLat     *string      `json:"loc_lat"`
+	LocLong    *string      `json:"loc_long"`
+	LocTrigger *string      `json:"loc_trigger"`
+	Radius     *int         `json:"radius"`
+	IsDeleted  intBool      `json:"is_deleted"`
+}
+
+func (srem *syncReminder) reminder() *Reminder {
+	rem := &Reminder{
+		ID:           srem.ID,
+		TaskID:       srem.ItemID,
+		NotifyUID:    srem.NotifyUID,
+		Type:         srem.Type,
+		MinuteOffset: srem.MmOffset,
+		Name:         srem.Name,
+		LocLat:       srem.LocLat,
+		LocLong:      srem.LocLong,
+		LocTrigger:   srem.LocTrigger,
+		Radius:       srem.Radius,
+	}
+	if srem.Due != nil {
+		rem.Due = srem.Due.due()
+	}
+
+	return rem
+}
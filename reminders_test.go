@@ -0,0 +1,188 @@
+package todoist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const remindersResponseForTest = `{
+	"sync_token": "TOKEN",
+	"reminders": [
+		{ "id": 1, "item_id": 10, "notify_uid": 100, "type": "relative", "due": null, "mm_offset": 30, "is_deleted": 0 },
+		{ "id": 2, "item_id": 20, "notify_uid": 100, "type": "absolute", "due": { "date": "2016-08-05T07:00:00Z", "timezone": null, "is_recurring": false, "string": "tomorrow 7am", "lang": "en" }, "is_deleted": 0 },
+		{ "id": 3, "item_id": 10, "notify_uid": 100, "type": "location", "name": "Office", "loc_lat": "35.6", "loc_long": "139.7", "loc_trigger": "on_enter", "radius": 100, "is_deleted": 0 },
+		{ "id": 4, "item_id": 10, "notify_uid": 100, "type": "relative", "mm_offset": 0, "is_deleted": 1 }
+	]
+}`
+
+func newRemindersRequestForTest() *restRequest {
+	return newSyncRequestForTest(map[string]interface{}{"sync_token": "*", "resource_types": []string{"reminders"}})
+}
+
+func TestClient_GetRemindersWithOptions(t *testing.T) {
+	rem1 := &Reminder{ID: 1, TaskID: 10, NotifyUID: 100, Type: ReminderTypeRelative, MinuteOffset: Int(30)}
+	rem2 := &Reminder{ID: 2, TaskID: 20, NotifyUID: 100, Type: ReminderTypeAbsolute, Due: &Due{Date: "2016-08-05", Datetime: String("2016-08-05T07:00:00Z"), String: "tomorrow 7am"}}
+	rem3 := &Reminder{ID: 3, TaskID: 10, NotifyUID: 100, Type: ReminderTypeLocation, Name: String("Office"), LocLat: String("35.6"), LocLong: String("139.7"), LocTrigger: String("on_enter"), Radius: Int(100)}
+
+	tests := []struct {
+		name    string
+		opts    *GetRemindersOptions
+		resp    *restResponse
+		want    Reminders
+		wantErr bool
+	}{
+		{
+			name:    "should return reminders",
+			opts:    nil,
+			resp:    &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(remindersResponseForTest)},
+			want:    Reminders{rem1, rem2, rem3},
+			wantErr: false,
+		},
+		{
+			name:    "should return reminders of a task",
+			opts:    &GetRemindersOptions{TaskID: Int(10)},
+			resp:    &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(remindersResponseForTest)},
+			want:    Reminders{rem1, rem3},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error if the request fails",
+			opts:    nil,
+			resp:    &restResponse{StatusCode: http.StatusBadRequest, Body: strings.NewReader("ERROR_RESPONSE")},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", newRemindersRequestForTest()).Return(tt.resp, nil)
+
+			rems, err := cl.GetRemindersWithOptions(tt.opts)
+
+			assert.Equal(t, tt.want, rems)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_CreateReminder(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *CreateReminderOptions
+		args    map[string]interface{}
+		resp    *restResponse
+		want    *Reminder
+		wantErr bool
+	}{
+		{
+			name: "should create a reminder",
+			opts: &CreateReminderOptions{MinuteOffset: Int(30)},
+			args: map[string]interface{}{"item_id": 10, "mm_offset": Int(30)},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": { "UUID_2": 1 } }`),
+			},
+			want:    &Reminder{ID: 1, TaskID: 10, NotifyUID: 100, Type: ReminderTypeRelative, MinuteOffset: Int(30)},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the command fails",
+			opts: nil,
+			args: map[string]interface{}{"item_id": 10},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": { "error_code": 22, "error": "Item not found", "http_code": 404 } }, "temp_id_mapping": {} }`),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			cmd := &syncCommand{Type: "reminder_add", UUID: "UUID_1", TempID: "UUID_2", Args: tt.args}
+			api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(tt.resp, nil)
+			if !tt.wantErr {
+				api.On("Do", newRemindersRequestForTest()).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(remindersResponseForTest)}, nil)
+			}
+
+			rem, err := cl.CreateReminder(10, tt.opts)
+
+			assert.Equal(t, tt.want, rem)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_UpdateReminder(t *testing.T) {
+	cl, api := newClientForTest()
+	typ := ReminderTypeAbsolute
+	due := &ReminderDue{String: String("tomorrow 9am")}
+	cmd := &syncCommand{Type: "reminder_update", UUID: "UUID_1", Args: map[string]interface{}{"id": 1, "type": &typ, "due": map[string]interface{}{"string": String("tomorrow 9am")}}}
+	api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(&restResponse{
+		StatusCode: http.StatusOK,
+		Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+	}, nil)
+
+	err := cl.UpdateReminder(1, &UpdateReminderOptions{Type: &typ, Due: due})
+
+	assert.NoError(t, err)
+	api.AssertExpectations(t)
+}
+
+func TestClient_DeleteReminder(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should delete a reminder",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" }, "temp_id_mapping": {} }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			cmd := &syncCommand{Type: "reminder_delete", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}}
+			api.On("Do", newSyncCommandsRequestForTest(cmd)).Return(tt.resp, nil)
+
+			err := cl.DeleteReminder(1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
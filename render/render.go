@@ -0,0 +1,275 @@
+// Package render renders Todoist-flavoured markdown in task content, task descriptions and comments
+// as plain text, HTML or terminal-styled text.
+package render
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Type of tokens.
+type TokenType int
+
+const (
+	// Plain text.
+	TokenText TokenType = iota
+	// Bold text (**text** or __text__).
+	TokenBold
+	// Italic text (*text* or _text_).
+	TokenItalic
+	// Inline code (`text`).
+	TokenCode
+	// Link ([text](url) or a bare URL).
+	TokenLink
+	// Label (@label).
+	TokenLabel
+	// Project (#project).
+	TokenProject
+	// Priority marker (!!1 to !!4).
+	TokenPriority
+)
+
+// Token of Todoist-flavoured markdown.
+type Token struct {
+	// Type of the token.
+	Type TokenType
+	// Text of the token without markup.
+	// For labels and projects, it is the name without @ or #.
+	// For priority markers, it is the priority from 1 (urgent) to 4.
+	Text string
+	// URL of the link.
+	URL string
+}
+
+// Link in Todoist-flavoured markdown.
+type Link struct {
+	// Link text.
+	Text string
+	// Link URL.
+	URL string
+}
+
+var tokenRe = regexp.MustCompile(strings.Join([]string{
+	`\[([^\]]+)\]\(([^)\s]+)\)`,   // 1, 2: link
+	`\*\*(.+?)\*\*`,               // 3: bold
+	`__(.+?)__`,                   // 4: bold
+	`\*([^*\s][^*]*?)\*`,          // 5: italic
+	`_([^_\s][^_]*?)_`,            // 6: italic
+	"`([^`]+)`",                   // 7: code
+	`(https?://[^\s<>()]+)`,       // 8: bare URL
+	`(?:^|\s)@([\p{L}\p{N}_\-]+)`, // 9: label
+	`(?:^|\s)#([\p{L}\p{N}_\-]+)`, // 10: project
+	`!!([1-4])`,                   // 11: priority
+}, "|"))
+
+// Parses Todoist-flavoured markdown into tokens.
+func Parse(s string) []Token {
+	tokens := []Token{}
+	text := new(strings.Builder)
+	flush := func() {
+		if text.Len() > 0 {
+			tokens = append(tokens, Token{Type: TokenText, Text: text.String()})
+			text.Reset()
+		}
+	}
+
+	prev := 0
+	for _, m := range tokenRe.FindAllStringSubmatchIndex(s, -1) {
+		group := 0
+		for g := 1; g*2 < len(m); g++ {
+			if m[g*2] >= 0 {
+				group = g
+				break
+			}
+		}
+		sub := func(g int) string { return s[m[g*2]:m[g*2+1]] }
+
+		start := m[0]
+		// underscores inside words (e.g. snake_case) are not emphasis.
+		if (group == 4 || group == 6) && start > 0 {
+			r, _ := utf8.DecodeLastRuneInString(s[:start])
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				continue
+			}
+		}
+		// labels and projects may match a leading whitespace.
+		if group == 9 || group == 10 {
+			start = m[group*2] - 1
+		}
+
+		text.WriteString(s[prev:start])
+		flush()
+
+		switch group {
+		case 1:
+			tokens = append(tokens, Token{Type: TokenLink, Text: sub(1), URL: sub(2)})
+		case 3, 4:
+			tokens = append(tokens, Token{Type: TokenBold, Text: sub(group)})
+		case 5, 6:
+			tokens = append(tokens, Token{Type: TokenItalic, Text: sub(group)})
+		case 7:
+			tokens = append(tokens, Token{Type: TokenCode, Text: sub(7)})
+		case 8:
+			tokens = append(tokens, Token{Type: TokenLink, Text: sub(8), URL: sub(8)})
+		case 9:
+			tokens = append(tokens, Token{Type: TokenLabel, Text: sub(9)})
+		case 10:
+			tokens = append(tokens, Token{Type: TokenProject, Text: sub(10)})
+		case 11:
+			tokens = append(tokens, Token{Type: TokenPriority, Text: sub(11)})
+		}
+		prev = m[1]
+	}
+	text.WriteString(s[prev:])
+	flush()
+
+	return tokens
+}
+
+// Returns links in Todoist-flavoured markdown, including bare URLs.
+func Links(s string) []Link {
+	links := []Link{}
+	for _, t := range Parse(s) {
+		if t.Type == TokenLink {
+			links = append(links, Link{Text: t.Text, URL: t.URL})
+		}
+	}
+	return links
+}
+
+// Renders Todoist-flavoured markdown as plain text.
+// Markup is removed, links are rendered as "text (url)" and priority markers are dropped.
+func PlainText(s string) string {
+	b := new(strings.Builder)
+	for _, t := range Parse(s) {
+		switch t.Type {
+		case TokenLink:
+			b.WriteString(linkText(t))
+		case TokenLabel:
+			b.WriteString("@" + t.Text)
+		case TokenProject:
+			b.WriteString("#" + t.Text)
+		case TokenPriority:
+		default:
+			b.WriteString(t.Text)
+		}
+	}
+	return tidy(b.String())
+}
+
+// Renders Todoist-flavoured markdown as HTML.
+// Labels and projects are rendered as spans with the todoist-label and todoist-project classes, and priority markers are dropped.
+// Only http, https and mailto links are rendered as anchors, and other links are rendered as text.
+func HTML(s string) string {
+	b := new(strings.Builder)
+	for _, t := range Parse(s) {
+		text := html.EscapeString(t.Text)
+		switch t.Type {
+		case TokenBold:
+			b.WriteString("<strong>" + text + "</strong>")
+		case TokenItalic:
+			b.WriteString("<em>" + text + "</em>")
+		case TokenCode:
+			b.WriteString("<code>" + text + "</code>")
+		case TokenLink:
+			if !safeURL(t.URL) {
+				b.WriteString(html.EscapeString(linkText(t)))
+				continue
+			}
+			fmt.Fprintf(b, `<a href="%s">%s</a>`, html.EscapeString(t.URL), text)
+		case TokenLabel:
+			b.WriteString(`<span class="todoist-label">@` + text + "</span>")
+		case TokenProject:
+			b.WriteString(`<span class="todoist-project">#` + text + "</span>")
+		case TokenPriority:
+		default:
+			b.WriteString(strings.ReplaceAll(text, "\n", "<br>\n"))
+		}
+	}
+	return tidy(b.String())
+}
+
+const (
+	ansiReset     string = "\x1b[0m"
+	ansiBold      string = "\x1b[1m"
+	ansiItalic    string = "\x1b[3m"
+	ansiUnderline string = "\x1b[4m"
+	ansiCyan      string = "\x1b[36m"
+	ansiMagenta   string = "\x1b[35m"
+	ansiBlue      string = "\x1b[34m"
+)
+
+// Renders Todoist-flavoured markdown as text styled with ANSI escape sequences.
+// Control characters of the text other than newlines and tabs are removed.
+func Terminal(s string) string {
+	b := new(strings.Builder)
+	for _, t := range Parse(s) {
+		t.Text = stripControl(t.Text)
+		t.URL = stripControl(t.URL)
+		switch t.Type {
+		case TokenBold:
+			b.WriteString(ansiBold + t.Text + ansiReset)
+		case TokenItalic:
+			b.WriteString(ansiItalic + t.Text + ansiReset)
+		case TokenCode:
+			b.WriteString(ansiCyan + t.Text + ansiReset)
+		case TokenLink:
+			if t.Text == t.URL {
+				b.WriteString(ansiUnderline + t.URL + ansiReset)
+			} else {
+				b.WriteString(ansiUnderline + t.Text + ansiReset + " (" + t.URL + ")")
+			}
+		case TokenLabel:
+			b.WriteString(ansiMagenta + "@" + t.Text + ansiReset)
+		case TokenProject:
+			b.WriteString(ansiBlue + "#" + t.Text + ansiReset)
+		case TokenPriority:
+		default:
+			b.WriteString(t.Text)
+		}
+	}
+	return tidy(b.String())
+}
+
+func linkText(t Token) string {
+	if t.Text == t.URL {
+		return t.URL
+	}
+	return t.Text + " (" + t.URL + ")"
+}
+
+// Returns whether a link URL is safe to render as an anchor.
+func safeURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto":
+		return true
+	default:
+		return false
+	}
+}
+
+// Removes control characters other than newlines and tabs, such as escape sequences in user content.
+func stripControl(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+var spacesRe = regexp.MustCompile(`[ \t]{2,}`)
+
+// Collapses spaces left by dropped tokens.
+func tidy(s string) string {
+	return strings.TrimSpace(spacesRe.ReplaceAllString(s, " "))
+}
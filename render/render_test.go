@@ -0,0 +1,88 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []Token
+	}{
+		{
+			name: "should parse markup and Todoist tokens",
+			s:    "Pay **rent** [bank](https://example.com/bank) @finance #Home !!1",
+			want: []Token{
+				{Type: TokenText, Text: "Pay "},
+				{Type: TokenBold, Text: "rent"},
+				{Type: TokenText, Text: " "},
+				{Type: TokenLink, Text: "bank", URL: "https://example.com/bank"},
+				{Type: TokenText, Text: " "},
+				{Type: TokenLabel, Text: "finance"},
+				{Type: TokenText, Text: " "},
+				{Type: TokenProject, Text: "Home"},
+				{Type: TokenText, Text: " "},
+				{Type: TokenPriority, Text: "1"},
+			},
+		},
+		{
+			name: "should parse italic, code and bare URLs",
+			s:    "*see* `code` https://example.com",
+			want: []Token{
+				{Type: TokenItalic, Text: "see"},
+				{Type: TokenText, Text: " "},
+				{Type: TokenCode, Text: "code"},
+				{Type: TokenText, Text: " "},
+				{Type: TokenLink, Text: "https://example.com", URL: "https://example.com"},
+			},
+		},
+		{
+			name: "should not parse emails, snake_case and headings as tokens",
+			s:    "mail user@example.com about snake_case_name # heading",
+			want: []Token{
+				{Type: TokenText, Text: "mail user@example.com about snake_case_name # heading"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Parse(tt.s))
+		})
+	}
+}
+
+func TestLinks(t *testing.T) {
+	t.Run("should return links", func(t *testing.T) {
+		assert.Equal(t, []Link{
+			{Text: "docs", URL: "https://example.com/docs"},
+			{Text: "https://example.com", URL: "https://example.com"},
+		}, Links("see [docs](https://example.com/docs) or https://example.com"))
+	})
+}
+
+func TestPlainText(t *testing.T) {
+	assert.Equal(t, "Pay rent bank (https://example.com/bank) @finance", PlainText("Pay **rent** !!1 [bank](https://example.com/bank) @finance"))
+}
+
+func TestHTML(t *testing.T) {
+	assert.Equal(t,
+		`<strong>a&lt;b</strong> <a href="https://example.com/?a=1&amp;b=2">link</a> <span class="todoist-label">@x</span> <span class="todoist-project">#y</span>`,
+		HTML("**a<b** [link](https://example.com/?a=1&b=2) @x #y !!2"),
+	)
+	assert.Equal(t,
+		`<a href="mailto:a@example.com">mail</a> x (javascript:alert(document.cookie))`,
+		HTML("[mail](mailto:a@example.com) [x](javascript:alert(document.cookie))"),
+	)
+	assert.Equal(t, `x (JavaScript:alert(1))`, HTML("[x](JavaScript:alert(1))"))
+}
+
+func TestTerminal(t *testing.T) {
+	assert.Equal(t,
+		"\x1b[1mbold\x1b[0m \x1b[4mlink\x1b[0m (https://example.com) \x1b[35m@x\x1b[0m",
+		Terminal("**bold** [link](https://example.com) @x"),
+	)
+	assert.Equal(t, "[2Jclear\nline", Terminal("\x1b[2Jclear\nline\x07"))
+}
@@ -2,6 +2,7 @@ package todoist
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -22,6 +23,8 @@ type restClient struct {
 var _ restAPI = (*restClient)(nil)
 
 type restRequest struct {
+	// Context of the request, or nil for the background context.
+	Context context.Context
 	URL     string
 	Method  string
 	Payload map[string]interface{}
@@ -30,6 +33,7 @@ type restRequest struct {
 
 type restResponse struct {
 	StatusCode int
+	Header     http.Header
 	Body       io.Reader
 }
 
@@ -46,7 +50,11 @@ func (cl *restClient) Do(req *restRequest) (*restResponse, error) {
 		}
 		p = bytes.NewBuffer(j)
 	}
-	httpreq, err := http.NewRequest(req.Method, req.URL, p)
+	ctx := req.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	httpreq, err := http.NewRequestWithContext(ctx, req.Method, req.URL, p)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +75,7 @@ func (cl *restClient) Do(req *restRequest) (*restResponse, error) {
 
 	return &restResponse{
 		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
 		Body:       buf,
 	}, nil
 }
@@ -2,6 +2,7 @@ package todoist
 
 import (
 	"fmt"
+	"sort"
 )
 
 type Section struct {
@@ -13,6 +14,8 @@ type Section struct {
 	Order int `json:"order"`
 	// Section name.
 	Name string `json:"name"`
+	// Whether the section is archived (read-only, use ArchiveSection and UnarchiveSection to change).
+	IsArchived bool `json:"is_archived"`
 }
 
 // List of sections.
@@ -131,3 +134,102 @@ func (cl *Client) DeleteSectionWithOptions(id int, opts *DeleteSectionOptions) e
 
 	return nil
 }
+
+// Reorders sections of the same project in the order of IDs.
+func (cl *Client) ReorderSections(ids []int) error {
+	secs := []map[string]interface{}{}
+	for i, id := range ids {
+		secs = append(secs, map[string]interface{}{"id": id, "section_order": i + 1})
+	}
+
+	if _, err := cl.syncCommands(cl.newCommand("section_reorder", map[string]interface{}{"sections": secs})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sorts sections of the same project by less and reorders them in that order.
+func (cl *Client) SortSections(secs Sections, less func(a, b *Section) bool) error {
+	sorted := make(Sections, len(secs))
+	copy(sorted, secs)
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+
+	ids := []int{}
+	for _, sec := range sorted {
+		ids = append(ids, sec.ID)
+	}
+
+	return cl.ReorderSections(ids)
+}
+
+// Archives a section and its tasks.
+func (cl *Client) ArchiveSection(id int) error {
+	if _, err := cl.syncCommands(cl.newCommand("section_archive", map[string]interface{}{"id": id})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Unarchives a section.
+func (cl *Client) UnarchiveSection(id int) error {
+	if _, err := cl.syncCommands(cl.newCommand("section_unarchive", map[string]interface{}{"id": id})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Options for getting archived sections.
+type GetArchivedSectionsOptions struct {
+	// Maximum number of sections to return.
+	Limit *int `json:"limit,omitempty"`
+	// Number of sections to skip, used for pagination.
+	Offset *int `json:"offset,omitempty"`
+}
+
+// Gets list of archived sections of a project.
+func (cl *Client) GetArchivedSections(projectID int) (Sections, error) {
+	return cl.GetArchivedSectionsWithOptions(projectID, nil)
+}
+
+// Gets list of archived sections of a project with options.
+func (cl *Client) GetArchivedSectionsWithOptions(projectID int, opts *GetArchivedSectionsOptions) (Sections, error) {
+	p := map[string]interface{}{"project_id": projectID}
+	if err := toMap(opts, p); err != nil {
+		return nil, err
+	}
+
+	ssecs := []*syncSection{}
+	if err := cl.syncPost("/v8/sections/get_archived", p, &ssecs); err != nil {
+		return nil, err
+	}
+
+	secs := Sections{}
+	for _, ssec := range ssecs {
+		secs = append(secs, ssec.section())
+	}
+
+	return secs, nil
+}
+
+// Section object of the Sync API.
+type syncSection struct {
+	ID           int     `json:"id"`
+	ProjectID    int     `json:"project_id"`
+	SectionOrder int     `json:"section_order"`
+	Name         string  `json:"name"`
+	IsArchived   intBool `json:"is_archived"`
+	IsDeleted    intBool `json:"is_deleted"`
+}
+
+func (ssec *syncSection) section() *Section {
+	return &Section{
+		ID:         ssec.ID,
+		ProjectID:  ssec.ProjectID,
+		Order:      ssec.SectionOrder,
+		Name:       ssec.Name,
+		IsArchived: bool(ssec.IsArchived),
+	}
+}
@@ -507,3 +507,230 @@ func TestClient_DeleteSectionWithOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ReorderSections(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should return nil",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the command fails",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": { "error_code": 20, "error": "ERROR" } } }`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", newSyncCommandsRequestForTest(&syncCommand{
+				Type: "section_reorder",
+				UUID: "UUID_1",
+				Args: map[string]interface{}{"sections": []map[string]interface{}{
+					{"id": 3, "section_order": 1},
+					{"id": 1, "section_order": 2},
+				}},
+			})).Return(tt.resp, nil)
+
+			err := cl.ReorderSections([]int{3, 1})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_SortSections(t *testing.T) {
+	t.Run("should reorder sorted sections", func(t *testing.T) {
+		cl, api := newClientForTest()
+
+		api.On("Do", newSyncCommandsRequestForTest(&syncCommand{
+			Type: "section_reorder",
+			UUID: "UUID_1",
+			Args: map[string]interface{}{"sections": []map[string]interface{}{
+				{"id": 2, "section_order": 1},
+				{"id": 3, "section_order": 2},
+				{"id": 1, "section_order": 3},
+			}},
+		})).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+		}, nil)
+
+		items := Sections{{ID: 1, Order: 30}, {ID: 2, Order: 10}, {ID: 3, Order: 20}}
+		err := cl.SortSections(items, func(a, b *Section) bool { return a.Order < b.Order })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, items[0].ID)
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_ArchiveSection(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should return nil",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", newSyncCommandsRequestForTest(
+				&syncCommand{Type: "section_archive", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}},
+			)).Return(tt.resp, nil)
+
+			err := cl.ArchiveSection(1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_UnarchiveSection(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should return nil",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", newSyncCommandsRequestForTest(
+				&syncCommand{Type: "section_unarchive", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}},
+			)).Return(tt.resp, nil)
+
+			err := cl.UnarchiveSection(1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_GetArchivedSectionsWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *GetArchivedSectionsOptions
+		payload map[string]interface{}
+		resp    *restResponse
+		want    Sections
+		wantErr bool
+	}{
+		{
+			name:    "should return archived sections",
+			opts:    &GetArchivedSectionsOptions{Limit: Int(10)},
+			payload: map[string]interface{}{"project_id": 1, "limit": Int(10)},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`[{ "id": 2, "project_id": 1, "section_order": 3, "name": "SECTION", "is_archived": true }]`),
+			},
+			want:    Sections{{ID: 2, ProjectID: 1, Order: 3, Name: "SECTION", IsArchived: true}},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error if the request fails",
+			opts:    nil,
+			payload: map[string]interface{}{"project_id": 1},
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", &restRequest{
+				URL:     "https://api.todoist.com/sync/v8/sections/get_archived",
+				Method:  http.MethodPost,
+				Payload: tt.payload,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+			}).Return(tt.resp, nil)
+
+			secs, err := cl.GetArchivedSectionsWithOptions(1, tt.opts)
+
+			assert.Equal(t, tt.want, secs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package todoist
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Maximum number of commands per Sync API request.
+const maxSyncCommands int = 100
+
+// Error of a Sync API command.
+type SyncError struct {
+	// Error code.
+	Code int `json:"error_code"`
+	// Error message.
+	Message string `json:"error"`
+	// HTTP status code corresponding to the error.
+	HTTPCode int `json:"http_code"`
+}
+
+func (err SyncError) Error() string {
+	return fmt.Sprintf("sync error: %d: %s", err.Code, err.Message)
+}
+
+type syncCommand struct {
+	Type   string                 `json:"type"`
+	UUID   string                 `json:"uuid"`
+	TempID string                 `json:"temp_id,omitempty"`
+	Args   map[string]interface{} `json:"args"`
+}
+
+type syncResponse struct {
+	SyncToken     string                     `json:"sync_token"`
+	FullSync      bool                       `json:"full_sync"`
+	SyncStatus    map[string]json.RawMessage `json:"sync_status"`
+	TempIDMapping map[string]int             `json:"temp_id_mapping"`
+}
+
+// Resources read from the Sync API.
+type syncResources struct {
+	Items        []*syncTask    `json:"items"`
+	Projects     []*syncProject `json:"projects"`
+	Sections     []*syncSection `json:"sections"`
+	Labels       []*syncLabel   `json:"labels"`
+	Notes        []*syncNote    `json:"notes"`
+	ProjectNotes []*syncNote    `json:"project_notes"`
+}
+
+// Returns the result of the command.
+func (resp *syncResponse) commandError(cmd *syncCommand) error {
+	st, ok := resp.SyncStatus[cmd.UUID]
+	if !ok {
+		return fmt.Errorf("sync error: no status for command %s", cmd.UUID)
+	}
+
+	var s string
+	if err := json.Unmarshal(st, &s); err == nil && s == "ok" {
+		return nil
+	}
+
+	serr := SyncError{}
+	if err := json.Unmarshal(st, &serr); err != nil {
+		return err
+	}
+	return serr
+}
+
+func (cl *Client) newCommand(typ string, args map[string]interface{}) *syncCommand {
+	return &syncCommand{Type: typ, UUID: cl.newUUID(), Args: args}
+}
+
+// Returns a command creating an object, whose ID is resolved by the temp ID.
+func (cl *Client) newCreateCommand(typ string, args map[string]interface{}) *syncCommand {
+	cmd := cl.newCommand(typ, args)
+	cmd.TempID = cl.newUUID()
+	return cmd
+}
+
+// Sends commands to the Sync API.
+// The returned error is only about the request itself, command results are in the sync status of the response.
+func (cl *Client) sync(cmds []*syncCommand, out interface{}) (*syncResponse, error) {
+	return cl.syncWithPayload(map[string]interface{}{"commands": cmds}, out)
+}
+
+// Reads resources from the Sync API.
+// A sync token "*" reads all resources.
+func (cl *Client) syncRead(syncToken string, resourceTypes []string, out interface{}) (*syncResponse, error) {
+	return cl.syncWithPayload(map[string]interface{}{"sync_token": syncToken, "resource_types": resourceTypes}, out)
+}
+
+func (cl *Client) syncWithPayload(p map[string]interface{}, out interface{}) (*syncResponse, error) {
+	raw := json.RawMessage{}
+	if err := cl.syncPost("/v8/sync", p, &raw); err != nil {
+		return nil, err
+	}
+
+	resp := syncResponse{}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	if out != nil {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return &resp, nil
+}
+
+// Sends commands to the Sync API and returns the first command error.
+func (cl *Client) syncCommands(cmds ...*syncCommand) (*syncResponse, error) {
+	resp, err := cl.sync(cmds, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cmd := range cmds {
+		if err := resp.commandError(cmd); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+func (cl *Client) syncPost(p string, payload map[string]interface{}, out interface{}) error {
+	body, err := cl.sendRequest(syncBaseUrl, p, nil, http.MethodPost, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewDecoder(body).Decode(out); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,92 @@
+package todoist
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSyncRequestForTest(payload map[string]interface{}) *restRequest {
+	return &restRequest{
+		URL:     "https://api.todoist.com/sync/v8/sync",
+		Method:  http.MethodPost,
+		Payload: payload,
+		Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+	}
+}
+
+func newSyncCommandsRequestForTest(cmds ...*syncCommand) *restRequest {
+	return newSyncRequestForTest(map[string]interface{}{"commands": cmds})
+}
+
+func TestClient_sync(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *restResponse
+		wantErrs []error
+		wantErr  bool
+	}{
+		{
+			name: "should return command results",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body: strings.NewReader(`{
+					"sync_token": "TOKEN",
+					"sync_status": {
+						"UUID_1": "ok",
+						"UUID_2": { "error_code": 20, "error": "Item not found", "http_code": 404 }
+					},
+					"temp_id_mapping": {}
+				}`),
+			},
+			wantErrs: []error{nil, SyncError{Code: 20, Message: "Item not found", HTTPCode: 404}},
+			wantErr:  false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			cmds := []*syncCommand{
+				{Type: "item_close", UUID: "UUID_1", Args: map[string]interface{}{"id": 1}},
+				{Type: "item_close", UUID: "UUID_2", Args: map[string]interface{}{"id": 2}},
+			}
+
+			api.On("Do", newSyncCommandsRequestForTest(cmds...)).Return(tt.resp, nil)
+
+			resp, err := cl.sync(cmds, nil)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "TOKEN", resp.SyncToken)
+				for i, cmd := range cmds {
+					assert.Equal(t, tt.wantErrs[i], resp.commandError(cmd))
+				}
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_newUUID(t *testing.T) {
+	t.Run("should return a random UUID v4", func(t *testing.T) {
+		u := newUUID()
+
+		assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), u)
+		assert.NotEqual(t, u, newUUID())
+	})
+}
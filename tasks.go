@@ -1,15 +1,19 @@
 package todoist
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 )
 
 type Task struct {
 	// Task ID.
 	ID int `json:"id"`
-	// Task's project ID (read-only).
+	// Task's project ID (read-only, use MoveTask to change).
 	ProjectID int `json:"project_id"`
-	// ID of section task belongs to.
+	// ID of section task belongs to (use MoveTask to change).
 	SectionID int `json:"section_id"`
 	// Task content.
 	// This value may contain markdown-formatted text and hyperlinks.
@@ -23,7 +27,7 @@ type Task struct {
 	Completed bool `json:"completed"`
 	// Array of label IDs, associated with a task.
 	LabelIDs []int `json:"label_ids"`
-	// ID of parent task (read-only, absent for top-level tasks).
+	// ID of parent task (read-only, use MoveTask to change, absent for top-level tasks).
 	ParentID *int `json:"parent_id"`
 	// Position under the same parent or project for top-level tasks (read-only).
 	Order int `json:"order"`
@@ -55,6 +59,9 @@ type Due struct {
 	Datetime *string `json:"datetime"`
 	// Only returned if exact due time set, user's timezone definition either in tzdata-compatible format ("Europe/Berlin") or as a string specifying east of UTC offset as "UTC±HH:MM" (i.e. "UTC-01:00").
 	Timezone *string `json:"timezone"`
+	// Only set for due dates of the Sync API with a floating time (the same local time in every timezone), date and time in format YYYY-MM-DDTHH:MM:SS without offset.
+	// Datetime is not set for floating times.
+	LocalDatetime *string `json:"local_datetime,omitempty"`
 }
 
 // Options for getting a tasks.
@@ -274,3 +281,177 @@ func (cl *Client) DeleteTaskWithOptions(id int, opts *DeleteTaskOptions) error {
 
 	return nil
 }
+
+// Destination of moving a task.
+// Exactly one of the fields must be set.
+type MoveTarget struct {
+	// ID of the destination project.
+	// The task is moved to the root of the project.
+	ProjectID *int `json:"project_id,omitempty"`
+	// ID of the destination section.
+	SectionID *int `json:"section_id,omitempty"`
+	// ID of the destination parent task.
+	ParentID *int `json:"parent_id,omitempty"`
+}
+
+// Returned when a move target does not have exactly one destination.
+var ErrInvalidMoveTarget = errors.New("exactly one of ProjectID, SectionID or ParentID must be set")
+
+func (t MoveTarget) args(id int) (map[string]interface{}, error) {
+	args := map[string]interface{}{"id": id}
+	if err := toMap(t, args); err != nil {
+		return nil, err
+	}
+	if len(args) != 2 {
+		return nil, ErrInvalidMoveTarget
+	}
+
+	return args, nil
+}
+
+// Moves a task to another project, section or parent task and returns the updated task.
+func (cl *Client) MoveTask(id int, target MoveTarget) (*Task, error) {
+	args, err := target.args(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cl.syncCommands(cl.newCommand("item_move", args)); err != nil {
+		return nil, err
+	}
+
+	return cl.GetTask(id)
+}
+
+// Reorders tasks under the same parent or project in the order of IDs.
+func (cl *Client) ReorderTasks(ids []int) error {
+	items := []map[string]interface{}{}
+	for i, id := range ids {
+		items = append(items, map[string]interface{}{"id": id, "child_order": i + 1})
+	}
+
+	if _, err := cl.syncCommands(cl.newCommand("item_reorder", map[string]interface{}{"items": items})); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sorts tasks under the same parent or project by less and reorders them in that order.
+func (cl *Client) SortTasks(tasks Tasks, less func(a, b *Task) bool) error {
+	sorted := make(Tasks, len(tasks))
+	copy(sorted, tasks)
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+
+	ids := []int{}
+	for _, task := range sorted {
+		ids = append(ids, task.ID)
+	}
+
+	return cl.ReorderTasks(ids)
+}
+
+// Task object of the Sync API.
+type syncTask struct {
+	ID             int      `json:"id"`
+	ProjectID      int      `json:"project_id"`
+	SectionID      *int     `json:"section_id"`
+	Content        string   `json:"content"`
+	Description    string   `json:"description"`
+	Checked        intBool  `json:"checked"`
+	Labels         []int    `json:"labels"`
+	ParentID       *int     `json:"parent_id"`
+	ChildOrder     int      `json:"child_order"`
+	Priority       int      `json:"priority"`
+	Due            *syncDue `json:"due"`
+	ResponsibleUID *int     `json:"responsible_uid"`
+	AssignedByUID  *int     `json:"assigned_by_uid"`
+	IsDeleted      intBool  `json:"is_deleted"`
+}
+
+// Due object of the Sync API.
+type syncDue struct {
+	Date        string  `json:"date"`
+	Timezone    *string `json:"timezone"`
+	String      string  `json:"string"`
+	IsRecurring bool    `json:"is_recurring"`
+}
+
+func (stask *syncTask) task() *Task {
+	task := &Task{
+		ID:          stask.ID,
+		ProjectID:   stask.ProjectID,
+		Content:     stask.Content,
+		Description: stask.Description,
+		Completed:   bool(stask.Checked),
+		LabelIDs:    stask.Labels,
+		ParentID:    stask.ParentID,
+		Order:       stask.ChildOrder,
+		Priority:    stask.Priority,
+		URL:         fmt.Sprintf("https://todoist.com/showTask?id=%d", stask.ID),
+		Assignee:    stask.ResponsibleUID,
+	}
+	if task.LabelIDs == nil {
+		task.LabelIDs = []int{}
+	}
+	if stask.SectionID != nil {
+		task.SectionID = *stask.SectionID
+	}
+	if stask.AssignedByUID != nil {
+		task.Assigner = *stask.AssignedByUID
+	}
+	if stask.Due != nil {
+		task.Due = stask.Due.due()
+	}
+
+	return task
+}
+
+func (sdue *syncDue) due() *Due {
+	due := &Due{String: sdue.String, Date: sdue.Date, Recurring: sdue.IsRecurring}
+	i := strings.Index(sdue.Date, "T")
+	if i < 0 {
+		return due
+	}
+	due.Date = sdue.Date[:i]
+	due.Timezone = sdue.Timezone
+
+	if t, err := time.Parse(time.RFC3339, sdue.Date); err == nil {
+		datetime := t.UTC().Format(time.RFC3339)
+		due.Datetime = &datetime
+		return due
+	}
+
+	// the date is floating, or local to the timezone if it is set.
+	local := sdue.Date
+	if sdue.Timezone != nil {
+		if loc, ok := dueLocation(*sdue.Timezone); ok {
+			if t, err := time.ParseInLocation("2006-01-02T15:04:05", local, loc); err == nil {
+				datetime := t.UTC().Format(time.RFC3339)
+				due.Datetime = &datetime
+				return due
+			}
+		}
+	}
+	due.LocalDatetime = &local
+
+	return due
+}
+
+// Returns the location of a due timezone, in tzdata format ("Europe/Berlin") or "UTC±HH:MM".
+func dueLocation(tz string) (*time.Location, bool) {
+	if offset, ok := strings.CutPrefix(tz, "UTC"); ok && offset != "" {
+		t, err := time.Parse("-07:00", offset)
+		if err != nil {
+			return nil, false
+		}
+		_, sec := t.Zone()
+		return time.FixedZone(tz, sec), true
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, false
+	}
+	return loc, true
+}
@@ -756,3 +756,192 @@ func TestClient_DeleteTaskWithOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_MoveTask(t *testing.T) {
+	type args struct {
+		id     int
+		target MoveTarget
+	}
+	tests := []struct {
+		name     string
+		args     args
+		syncResp *restResponse
+		resp     *restResponse
+		want     *Task
+		wantErr  error
+	}{
+		{
+			name: "should return a moved task",
+			args: args{id: 1, target: MoveTarget{ProjectID: Int(2)}},
+			syncResp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "id": 1, "project_id": 2, "content": "TASK" }`),
+			},
+			want: &Task{ID: 1, ProjectID: 2, Content: "TASK"},
+		},
+		{
+			name: "should return an error if the command fails",
+			args: args{id: 1, target: MoveTarget{ProjectID: Int(2)}},
+			syncResp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": { "error_code": 20, "error": "Item not found" } } }`),
+			},
+			want:    nil,
+			wantErr: SyncError{Code: 20, Message: "Item not found"},
+		},
+		{
+			name:    "should return an error if the target is invalid",
+			args:    args{id: 1, target: MoveTarget{}},
+			want:    nil,
+			wantErr: ErrInvalidMoveTarget,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			if tt.syncResp != nil {
+				api.On("Do", newSyncCommandsRequestForTest(
+					&syncCommand{Type: "item_move", UUID: "UUID_1", Args: map[string]interface{}{"id": tt.args.id, "project_id": tt.args.target.ProjectID}},
+				)).Return(tt.syncResp, nil)
+			}
+			if tt.resp != nil {
+				api.On("Do", &restRequest{
+					URL:     fmt.Sprintf("https://api.todoist.com/rest/v1/tasks/%d", tt.args.id),
+					Method:  http.MethodGet,
+					Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+				}).Return(tt.resp, nil)
+			}
+
+			task, err := cl.MoveTask(tt.args.id, tt.args.target)
+
+			assert.Equal(t, tt.want, task)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_ReorderTasks(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		wantErr bool
+	}{
+		{
+			name: "should return nil",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the command fails",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "sync_status": { "UUID_1": { "error_code": 20, "error": "ERROR" } } }`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+
+			api.On("Do", newSyncCommandsRequestForTest(&syncCommand{
+				Type: "item_reorder",
+				UUID: "UUID_1",
+				Args: map[string]interface{}{"items": []map[string]interface{}{
+					{"id": 3, "child_order": 1},
+					{"id": 1, "child_order": 2},
+				}},
+			})).Return(tt.resp, nil)
+
+			err := cl.ReorderTasks([]int{3, 1})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_SortTasks(t *testing.T) {
+	t.Run("should reorder sorted tasks", func(t *testing.T) {
+		cl, api := newClientForTest()
+
+		api.On("Do", newSyncCommandsRequestForTest(&syncCommand{
+			Type: "item_reorder",
+			UUID: "UUID_1",
+			Args: map[string]interface{}{"items": []map[string]interface{}{
+				{"id": 2, "child_order": 1},
+				{"id": 3, "child_order": 2},
+				{"id": 1, "child_order": 3},
+			}},
+		})).Return(&restResponse{
+			StatusCode: http.StatusOK,
+			Body:       strings.NewReader(`{ "sync_status": { "UUID_1": "ok" } }`),
+		}, nil)
+
+		items := Tasks{{ID: 1, Order: 30}, {ID: 2, Order: 10}, {ID: 3, Order: 20}}
+		err := cl.SortTasks(items, func(a, b *Task) bool { return a.Order < b.Order })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, items[0].ID)
+		api.AssertExpectations(t)
+	})
+}
+
+func Test_syncDue_due(t *testing.T) {
+	tests := []struct {
+		name string
+		sdue *syncDue
+		want *Due
+	}{
+		{
+			name: "should return an all-day due date",
+			sdue: &syncDue{Date: "2016-12-06", String: "Dec 6"},
+			want: &Due{Date: "2016-12-06", String: "Dec 6"},
+		},
+		{
+			name: "should return a due time in UTC",
+			sdue: &syncDue{Date: "2016-12-06T13:00:00Z", Timezone: String("Asia/Tokyo"), String: "Dec 6 10pm"},
+			want: &Due{Date: "2016-12-06", Datetime: String("2016-12-06T13:00:00Z"), Timezone: String("Asia/Tokyo"), String: "Dec 6 10pm"},
+		},
+		{
+			name: "should convert a local due time with a UTC offset timezone to UTC",
+			sdue: &syncDue{Date: "2016-12-06T13:00:00", Timezone: String("UTC+09:00"), String: "Dec 6 1pm"},
+			want: &Due{Date: "2016-12-06", Datetime: String("2016-12-06T04:00:00Z"), Timezone: String("UTC+09:00"), String: "Dec 6 1pm"},
+		},
+		{
+			name: "should return a floating due time as a local datetime",
+			sdue: &syncDue{Date: "2016-12-06T13:00:00", String: "Dec 6 1pm"},
+			want: &Due{Date: "2016-12-06", LocalDatetime: String("2016-12-06T13:00:00"), String: "Dec 6 1pm"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.sdue.due())
+		})
+	}
+}
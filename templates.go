@@ -0,0 +1,280 @@
+package todoist
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Columns of the Todoist CSV template format.
+var templateColumns = []string{"TYPE", "CONTENT", "DESCRIPTION", "PRIORITY", "INDENT", "AUTHOR", "RESPONSIBLE", "DATE", "DATE_LANG", "TIMEZONE"}
+
+const (
+	templateTypeSection string = "section"
+	templateTypeTask    string = "task"
+	templateTypeNote    string = "note"
+)
+
+// Options for importing a project template.
+type ImportOptions struct {
+	// ID of an existing project to import into.
+	// If not set, a new project is created with ProjectName.
+	ProjectID *int
+	// Name of the project to create.
+	// Required if ProjectID is not set.
+	ProjectName *string
+}
+
+// Result of importing a project template.
+type ImportResult struct {
+	// Project the template was imported into.
+	Project *Project
+	// Created sections.
+	Sections Sections
+	// Created tasks.
+	Tasks Tasks
+	// Created comments.
+	Comments Comments
+}
+
+// Exports a project as a Todoist CSV template.
+// Sections, tasks (with subtasks as indentation) and comments as notes are exported.
+// Project comments are written as notes before the first task.
+func (cl *Client) ExportProjectTemplate(projectID int, w io.Writer) error {
+	secs, err := cl.GetSectionsWithOptions(&GetSectionsOptions{ProjectID: &projectID})
+	if err != nil {
+		return err
+	}
+	tasks, err := cl.GetTasksWithOptions(&GetTasksOptions{ProjectID: &projectID})
+	if err != nil {
+		return err
+	}
+	tasks = sortTaskTree(tasks)
+	pcmts, err := cl.GetProjectComments(projectID)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(templateColumns); err != nil {
+		return err
+	}
+	for _, cmt := range pcmts {
+		if err := cw.Write(templateRow(templateTypeNote, cmt.Content)); err != nil {
+			return err
+		}
+	}
+
+	depths := map[int]int{}
+	for _, task := range tasks {
+		if task.ParentID != nil {
+			depths[task.ID] = depths[*task.ParentID] + 1
+		}
+	}
+
+	writeTasks := func(sectionID int) error {
+		for _, task := range tasks {
+			if task.SectionID != sectionID {
+				continue
+			}
+			if err := cw.Write(taskTemplateRecord(task, depths[task.ID]+1)); err != nil {
+				return err
+			}
+
+			if task.CommentCount == 0 {
+				continue
+			}
+			cmts, err := cl.GetTaskComments(task.ID)
+			if err != nil {
+				return err
+			}
+			for _, cmt := range cmts {
+				if err := cw.Write(templateRow(templateTypeNote, cmt.Content)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := writeTasks(0); err != nil {
+		return err
+	}
+	for _, sec := range secs {
+		if err := cw.Write(templateRow("", "")); err != nil {
+			return err
+		}
+		if err := cw.Write(templateRow(templateTypeSection, sec.Name)); err != nil {
+			return err
+		}
+		if err := writeTasks(sec.ID); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// Imports a Todoist CSV template into a project.
+func (cl *Client) ImportProjectTemplate(r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.ToUpper(strings.TrimSpace(h))] = i
+	}
+	if _, ok := cols["TYPE"]; !ok {
+		return nil, errors.New("invalid template: TYPE column is missing")
+	}
+	if _, ok := cols["CONTENT"]; !ok {
+		return nil, errors.New("invalid template: CONTENT column is missing")
+	}
+	field := func(rec []string, col string) string {
+		i, ok := cols[col]
+		if !ok || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+
+	res := &ImportResult{}
+	if opts.ProjectID != nil {
+		res.Project, err = cl.GetProject(*opts.ProjectID)
+	} else if opts.ProjectName != nil {
+		res.Project, err = cl.CreateProject(*opts.ProjectName)
+	} else {
+		err = errors.New("either ProjectID or ProjectName must be set")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var sectionID *int
+	var lastTaskID *int
+	// IDs of the last tasks per indent level.
+	parents := []int{}
+
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return res, err
+		}
+
+		content := field(rec, "CONTENT")
+		switch strings.ToLower(field(rec, "TYPE")) {
+		case templateTypeSection:
+			sec, err := cl.CreateSection(content, res.Project.ID)
+			if err != nil {
+				return res, err
+			}
+			res.Sections = append(res.Sections, sec)
+			sectionID = &sec.ID
+			lastTaskID = nil
+			parents = []int{}
+		case templateTypeTask:
+			indent := 1
+			if s := field(rec, "INDENT"); s != "" {
+				indent, err = strconv.Atoi(s)
+				if err != nil || indent < 1 {
+					return res, fmt.Errorf("invalid template: line %d: invalid INDENT: %s", line, s)
+				}
+			}
+			if indent > len(parents)+1 {
+				return res, fmt.Errorf("invalid template: line %d: INDENT %d has no parent task", line, indent)
+			}
+			parents = parents[:indent-1]
+
+			topts := &CreateTaskOptions{ProjectID: &res.Project.ID, SectionID: sectionID}
+			if len(parents) > 0 {
+				parentID := parents[len(parents)-1]
+				topts.ParentID = &parentID
+			}
+			if s := field(rec, "DESCRIPTION"); s != "" {
+				topts.Description = &s
+			}
+			if s := field(rec, "PRIORITY"); s != "" {
+				p, err := strconv.Atoi(s)
+				if err != nil || p < 1 || 4 < p {
+					return res, fmt.Errorf("invalid template: line %d: invalid PRIORITY: %s", line, s)
+				}
+				p = 5 - p
+				topts.Priority = &p
+			}
+			if s := field(rec, "DATE"); s != "" {
+				topts.DueString = &s
+				if lang := field(rec, "DATE_LANG"); lang != "" {
+					topts.DueLang = &lang
+				}
+			}
+
+			task, err := cl.CreateTaskWithOptions(content, topts)
+			if err != nil {
+				return res, err
+			}
+			res.Tasks = append(res.Tasks, task)
+			parents = append(parents, task.ID)
+			lastTaskID = &task.ID
+		case templateTypeNote:
+			var cmt *Comment
+			if lastTaskID != nil {
+				cmt, err = cl.CreateTaskComment(*lastTaskID, content)
+			} else {
+				cmt, err = cl.CreateProjectComment(res.Project.ID, content)
+			}
+			if err != nil {
+				return res, err
+			}
+			res.Comments = append(res.Comments, cmt)
+		case "":
+			// blank rows separate sections.
+		default:
+			return res, fmt.Errorf("invalid template: line %d: unknown TYPE: %s", line, field(rec, "TYPE"))
+		}
+	}
+
+	return res, nil
+}
+
+func taskTemplateRecord(task *Task, indent int) []string {
+	// priority of the template is from 1 (urgent) to 4 (normal).
+	priority := ""
+	if task.Priority > 0 {
+		priority = strconv.Itoa(5 - task.Priority)
+	}
+
+	// the language of the due string is unknown, so DATE_LANG is left empty.
+	var date, tz string
+	if task.Due != nil {
+		date = task.Due.String
+		if task.Due.Timezone != nil {
+			tz = *task.Due.Timezone
+		}
+	}
+
+	responsible := ""
+	if task.Assignee != nil {
+		responsible = strconv.Itoa(*task.Assignee)
+	}
+
+	return []string{templateTypeTask, task.Content, task.Description, priority, strconv.Itoa(indent), "", responsible, date, "", tz}
+}
+
+// Returns a row with only TYPE and CONTENT.
+func templateRow(typ, content string) []string {
+	row := make([]string, len(templateColumns))
+	row[0] = typ
+	row[1] = content
+	return row
+}
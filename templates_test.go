@@ -0,0 +1,114 @@
+package todoist
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ExportProjectTemplate(t *testing.T) {
+	t.Run("should export a project as CSV template", func(t *testing.T) {
+		cl, api := newClientForTest()
+		get := func(url, body string) {
+			api.On("Do", &restRequest{
+				URL:     url,
+				Method:  http.MethodGet,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil)
+		}
+		get("https://api.todoist.com/rest/v1/sections?project_id=1", `[{ "id": 10, "project_id": 1, "order": 1, "name": "SECTION" }]`)
+		get("https://api.todoist.com/rest/v1/tasks?project_id=1", `[
+			{ "id": 100, "content": "TASK", "priority": 1, "order": 1 },
+			{ "id": 101, "section_id": 10, "content": "SECTION TASK", "description": "DESCRIPTION", "priority": 4, "order": 1, "comment_count": 1, "due": { "string": "tomorrow", "timezone": "Asia/Tokyo" } },
+			{ "id": 102, "section_id": 10, "parent_id": 101, "content": "SUBTASK", "priority": 1, "order": 1 }
+		]`)
+		get("https://api.todoist.com/rest/v1/comments?project_id=1", `[{ "id": 2000, "content": "PROJECT NOTE" }]`)
+		get("https://api.todoist.com/rest/v1/comments?task_id=101", `[{ "id": 1000, "content": "NOTE" }]`)
+
+		buf := new(bytes.Buffer)
+		err := cl.ExportProjectTemplate(1, buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"TYPE,CONTENT,DESCRIPTION,PRIORITY,INDENT,AUTHOR,RESPONSIBLE,DATE,DATE_LANG,TIMEZONE",
+			"note,PROJECT NOTE,,,,,,,,",
+			"task,TASK,,4,1,,,,,",
+			",,,,,,,,,",
+			"section,SECTION,,,,,,,,",
+			"task,SECTION TASK,DESCRIPTION,1,1,,,tomorrow,,Asia/Tokyo",
+			"note,NOTE,,,,,,,,",
+			"task,SUBTASK,,4,2,,,,,",
+			"",
+		}, "\n"), buf.String())
+		api.AssertExpectations(t)
+	})
+}
+
+func TestClient_ImportProjectTemplate(t *testing.T) {
+	t.Run("should import a CSV template into a new project", func(t *testing.T) {
+		cl, api := newClientForTest()
+		post := func(url string, payload map[string]interface{}, body string) {
+			api.On("Do", &restRequest{
+				URL:     url,
+				Method:  http.MethodPost,
+				Payload: payload,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+			}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}, nil).Once()
+		}
+		post("https://api.todoist.com/rest/v1/projects", map[string]interface{}{"name": "PROJECT"}, `{ "id": 1, "name": "PROJECT" }`)
+		post("https://api.todoist.com/rest/v1/comments", map[string]interface{}{"project_id": 1, "content": "PROJECT NOTE"}, `{ "id": 1000 }`)
+		post("https://api.todoist.com/rest/v1/sections", map[string]interface{}{"name": "SECTION", "project_id": 1}, `{ "id": 10 }`)
+		post("https://api.todoist.com/rest/v1/tasks", map[string]interface{}{
+			"content":     "TASK",
+			"description": String("DESCRIPTION"),
+			"project_id":  Int(1),
+			"section_id":  Int(10),
+			"priority":    Int(4),
+			"due_string":  String("tomorrow"),
+			"due_lang":    String("en"),
+		}, `{ "id": 100 }`)
+		post("https://api.todoist.com/rest/v1/tasks", map[string]interface{}{
+			"content":    "SUBTASK",
+			"project_id": Int(1),
+			"section_id": Int(10),
+			"parent_id":  Int(100),
+		}, `{ "id": 101 }`)
+		post("https://api.todoist.com/rest/v1/comments", map[string]interface{}{"task_id": 101, "content": "NOTE"}, `{ "id": 1001 }`)
+
+		tmpl := strings.Join([]string{
+			"TYPE,CONTENT,DESCRIPTION,PRIORITY,INDENT,AUTHOR,RESPONSIBLE,DATE,DATE_LANG,TIMEZONE",
+			"note,PROJECT NOTE,,,,,,,,",
+			"",
+			"section,SECTION,,,,,,,,",
+			"task,TASK,DESCRIPTION,1,1,,,tomorrow,en,",
+			"task,SUBTASK,,,2,,,,,",
+			"note,NOTE,,,,,,,,",
+		}, "\n")
+		res, err := cl.ImportProjectTemplate(strings.NewReader(tmpl), ImportOptions{ProjectName: String("PROJECT")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Project.ID)
+		assert.Len(t, res.Sections, 1)
+		assert.Len(t, res.Tasks, 2)
+		assert.Len(t, res.Comments, 2)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should return an error if an indent has no parent", func(t *testing.T) {
+		cl, api := newClientForTest()
+		api.On("Do", &restRequest{
+			URL:     "https://api.todoist.com/rest/v1/projects/1",
+			Method:  http.MethodGet,
+			Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+		}).Return(&restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(`{ "id": 1 }`)}, nil)
+
+		tmpl := "TYPE,CONTENT,INDENT\ntask,SUBTASK,2\n"
+		_, err := cl.ImportProjectTemplate(strings.NewReader(tmpl), ImportOptions{ProjectID: Int(1)})
+
+		assert.EqualError(t, err, "invalid template: line 2: INDENT 2 has no parent task")
+		api.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,202 @@
+package todoist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Names of the metrics recorded by the client.
+const (
+	// Counter of API requests.
+	MetricRequests string = "todoist.requests"
+	// Counter of failed API requests.
+	MetricErrors string = "todoist.errors"
+	// Histogram of API request latency in seconds.
+	MetricLatency string = "todoist.request.duration"
+)
+
+// Tracer starts a span for each API operation.
+// It can be implemented as a thin adapter over an OpenTelemetry tracer.
+type Tracer interface {
+	// Starts a span with the operation name (e.g. "todoist.tasks.create") as a child of the span in ctx,
+	// and returns a context containing the new span.
+	// ctx is the context set by Client.WithContext, or the background context.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span of an API operation.
+type Span interface {
+	// Sets an attribute of the span.
+	SetAttribute(key string, value interface{})
+	// Records an error of the operation.
+	RecordError(err error)
+	// Ends the span.
+	End()
+}
+
+// Meter records metrics of API operations.
+// It can be implemented as a thin adapter over an OpenTelemetry meter.
+type Meter interface {
+	// Adds delta to the counter with the name.
+	AddCounter(name string, delta int64, attrs map[string]string)
+	// Records value to the histogram with the name.
+	RecordHistogram(name string, value float64, attrs map[string]string)
+}
+
+type tracingAPI struct {
+	restAPI restAPI
+	tracer  Tracer
+	meter   Meter
+}
+
+var _ restAPI = (*tracingAPI)(nil)
+
+func newTracingAPI(api restAPI, tracer Tracer, meter Meter) *tracingAPI {
+	return &tracingAPI{restAPI: api, tracer: tracer, meter: meter}
+}
+
+func (api *tracingAPI) Do(req *restRequest) (*restResponse, error) {
+	op := operationName(req)
+
+	var span Span
+	if api.tracer != nil {
+		ctx := req.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		ctx, span = api.tracer.Start(ctx, op)
+		defer span.End()
+		span.SetAttribute("http.method", req.Method)
+		span.SetAttribute("http.url", req.URL)
+
+		spanreq := *req
+		spanreq.Context = ctx
+		req = &spanreq
+	}
+
+	start := time.Now()
+	resp, err := api.restAPI.Do(req)
+	latency := time.Since(start)
+
+	attrs := map[string]string{"operation": op, "method": req.Method}
+	failure := err
+	if err == nil {
+		attrs["status"] = fmt.Sprint(resp.StatusCode)
+		if failedStatus(resp.StatusCode) {
+			failure = RequestError{StatusCode: resp.StatusCode}
+		}
+	}
+
+	if span != nil {
+		if err == nil {
+			span.SetAttribute("http.status_code", resp.StatusCode)
+		}
+		if failure != nil {
+			span.RecordError(failure)
+		}
+	}
+
+	if api.meter != nil {
+		api.meter.AddCounter(MetricRequests, 1, attrs)
+		if failure != nil {
+			api.meter.AddCounter(MetricErrors, 1, attrs)
+		}
+		api.meter.RecordHistogram(MetricLatency, latency.Seconds(), attrs)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Returns the operation name for a request, named after the resource and the action.
+// e.g. "POST /rest/v1/tasks" => "todoist.tasks.create", "POST /rest/v1/tasks/1/close" => "todoist.tasks.close"
+// Sync API commands are named in the same way if all commands of the request have the same type.
+// e.g. "POST /sync/v8/sync" with item_move commands => "todoist.tasks.move"
+func operationName(req *restRequest) string {
+	method := req.Method
+	segs := resourceSegments(req.URL)
+	if strings.HasPrefix(requestPath(req.URL), "/sync/") {
+		cmds, ok := req.Payload["commands"].([]*syncCommand)
+		if !ok || len(cmds) == 0 {
+			return "todoist." + strings.Join(segs, ".")
+		}
+		typ := cmds[0].Type
+		for _, cmd := range cmds {
+			if cmd.Type != typ {
+				return "todoist.sync"
+			}
+		}
+		return "todoist." + commandOperation(typ)
+	}
+
+	res := segs[0]
+	var action string
+	switch len(segs) {
+	case 1:
+		switch method {
+		case http.MethodGet:
+			action = "list"
+		case http.MethodPost:
+			action = "create"
+		}
+	case 2:
+		switch method {
+		case http.MethodGet:
+			action = "get"
+		case http.MethodPost:
+			action = "update"
+		case http.MethodDelete:
+			action = "delete"
+		}
+	default:
+		action = segs[2]
+	}
+	if action == "" {
+		action = strings.ToLower(method)
+	}
+
+	return fmt.Sprintf("todoist.%s.%s", res, action)
+}
+
+// Resources per object prefix of Sync API command types.
+var commandResources = map[string]string{
+	"item":     "tasks",
+	"project":  "projects",
+	"section":  "sections",
+	"label":    "labels",
+	"note":     "comments",
+	"reminder": "reminders",
+	"filter":   "filters",
+}
+
+// Operations of Sync API command types that are not named "<object>_<action>".
+var commandOperations = map[string]string{
+	"share_project":       "projects.share",
+	"delete_collaborator": "projects.unshare",
+	"accept_invitation":   "invitations.accept",
+	"reject_invitation":   "invitations.reject",
+}
+
+// Returns the resource and the action of a Sync API command type.
+// e.g. "item_move" => "tasks.move", "filter_add" => "filters.create"
+func commandOperation(typ string) string {
+	if op, ok := commandOperations[typ]; ok {
+		return op
+	}
+
+	obj, action, ok := strings.Cut(typ, "_")
+	res, known := commandResources[obj]
+	if !ok || !known {
+		return "sync." + typ
+	}
+	if action == "add" {
+		action = "create"
+	}
+
+	return res + "." + action
+}
@@ -0,0 +1,173 @@
+package todoist
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type recordedSpanKey struct{}
+
+type recordedSpan struct {
+	name   string
+	parent *recordedSpan
+	attrs  map[string]interface{}
+	errs   []error
+	ended  bool
+}
+
+func (s *recordedSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *recordedSpan) RecordError(err error)                      { s.errs = append(s.errs, err) }
+func (s *recordedSpan) End()                                       { s.ended = true }
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (tr *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &recordedSpan{name: name, attrs: map[string]interface{}{}}
+	s.parent, _ = ctx.Value(recordedSpanKey{}).(*recordedSpan)
+	tr.spans = append(tr.spans, s)
+	return context.WithValue(ctx, recordedSpanKey{}, s), s
+}
+
+type recordingMeter struct {
+	counters   map[string]int64
+	histograms map[string][]float64
+	attrs      []map[string]string
+}
+
+func newRecordingMeter() *recordingMeter {
+	return &recordingMeter{counters: map[string]int64{}, histograms: map[string][]float64{}}
+}
+
+func (m *recordingMeter) AddCounter(name string, delta int64, attrs map[string]string) {
+	m.counters[name] += delta
+	m.attrs = append(m.attrs, attrs)
+}
+
+func (m *recordingMeter) RecordHistogram(name string, value float64, attrs map[string]string) {
+	m.histograms[name] = append(m.histograms[name], value)
+}
+
+func TestTracingAPI_Do(t *testing.T) {
+	tests := []struct {
+		name        string
+		resp        *restResponse
+		respErr     error
+		wantErrs    int64
+		wantSpanErr bool
+		wantErr     bool
+		wantStatus  string
+	}{
+		{
+			name: "should record a successful request",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body:       strings.NewReader(`{ "id": 1 }`),
+			},
+			wantErrs:    0,
+			wantSpanErr: false,
+			wantErr:     false,
+			wantStatus:  "200",
+		},
+		{
+			name: "should record an error response",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			wantErrs:    1,
+			wantSpanErr: true,
+			wantErr:     false,
+			wantStatus:  "400",
+		},
+		{
+			name:        "should record an error",
+			respErr:     errors.New("ERROR"),
+			wantErrs:    1,
+			wantSpanErr: true,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapi := &mockRestAPI{}
+			tr := &recordingTracer{}
+			m := newRecordingMeter()
+			api := newTracingAPI(mapi, tr, m)
+
+			parent := &recordedSpan{name: "PARENT"}
+			req := &restRequest{
+				Context: context.WithValue(context.Background(), recordedSpanKey{}, parent),
+				URL:     "https://api.todoist.com/rest/v1/tasks",
+				Method:  http.MethodPost,
+				Headers: map[string]string{"Authorization": "Bearer TOKEN"},
+			}
+			var sent *restRequest
+			mapi.On("Do", mock.MatchedBy(func(r *restRequest) bool {
+				sent = r
+				return r.URL == req.URL && r.Method == req.Method
+			})).Return(tt.resp, tt.respErr)
+
+			resp, err := api.Do(req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.resp, resp)
+			}
+
+			if assert.Len(t, tr.spans, 1) {
+				assert.Equal(t, "todoist.tasks.create", tr.spans[0].name)
+				assert.Same(t, parent, tr.spans[0].parent)
+				assert.Same(t, tr.spans[0], sent.Context.Value(recordedSpanKey{}))
+				assert.True(t, tr.spans[0].ended)
+				assert.Equal(t, tt.wantSpanErr, len(tr.spans[0].errs) > 0)
+			}
+			assert.Equal(t, int64(1), m.counters[MetricRequests])
+			assert.Equal(t, tt.wantErrs, m.counters[MetricErrors])
+			assert.Len(t, m.histograms[MetricLatency], 1)
+			assert.Equal(t, "todoist.tasks.create", m.attrs[0]["operation"])
+			assert.Equal(t, tt.wantStatus, m.attrs[0]["status"])
+			mapi.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_operationName(t *testing.T) {
+	tests := []struct {
+		method  string
+		url     string
+		payload map[string]interface{}
+		want    string
+	}{
+		{http.MethodGet, "https://api.todoist.com/rest/v1/tasks?project_id=1", nil, "todoist.tasks.list"},
+		{http.MethodPost, "https://api.todoist.com/rest/v1/tasks", nil, "todoist.tasks.create"},
+		{http.MethodGet, "https://api.todoist.com/rest/v1/tasks/1", nil, "todoist.tasks.get"},
+		{http.MethodPost, "https://api.todoist.com/rest/v1/tasks/1", nil, "todoist.tasks.update"},
+		{http.MethodDelete, "https://api.todoist.com/rest/v1/tasks/1", nil, "todoist.tasks.delete"},
+		{http.MethodPost, "https://api.todoist.com/rest/v1/tasks/1/close", nil, "todoist.tasks.close"},
+		{http.MethodGet, "https://api.todoist.com/rest/v1/projects/1/collaborators", nil, "todoist.projects.collaborators"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/sync", map[string]interface{}{"commands": []*syncCommand{{Type: "item_move"}, {Type: "item_move"}}}, "todoist.tasks.move"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/sync", map[string]interface{}{"commands": []*syncCommand{{Type: "item_move"}, {Type: "item_close"}}}, "todoist.sync"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/sync", map[string]interface{}{"commands": []*syncCommand{{Type: "filter_add"}}}, "todoist.filters.create"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/sync", map[string]interface{}{"commands": []*syncCommand{{Type: "note_update"}}}, "todoist.comments.update"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/sync", map[string]interface{}{"commands": []*syncCommand{{Type: "share_project"}}}, "todoist.projects.share"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/sync", map[string]interface{}{"commands": []*syncCommand{{Type: "user_update"}}}, "todoist.sync.user_update"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/sync", map[string]interface{}{"sync_token": "*"}, "todoist.sync"},
+		{http.MethodPost, "https://api.todoist.com/sync/v8/quick/add", nil, "todoist.quick.add"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, operationName(&restRequest{Method: tt.method, URL: tt.url, Payload: tt.payload}))
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package todoist
 
+import "errors"
+
 type User struct {
 	// User ID.
 	ID int `json:"id"`
@@ -7,7 +9,254 @@ type User struct {
 	Name string `json:"name"`
 	// User email address.
 	Email string `json:"email"`
+	// State of the user in the project (only set by GetCollaboratorStates).
+	State CollaboratorState `json:"state,omitempty"`
+	// IDs of the shared projects the user is an active collaborator of (only set by GetCollaboratorStates).
+	ProjectIDs []int `json:"project_ids,omitempty"`
 }
 
 // List of users.
 type Users []*User
+
+// Profile and settings of the current user.
+type CurrentUser struct {
+	// User ID.
+	ID int `json:"id"`
+	// User full name.
+	Name string `json:"name"`
+	// User email address.
+	Email string `json:"email"`
+	// Timezone of the user (e.g. "Europe/Rome").
+	Timezone string `json:"timezone"`
+	// First day of the week, from 1 (Monday) to 7 (Sunday).
+	StartDay int `json:"start_day"`
+	// Day to use when postponing tasks to next week, from 1 (Monday) to 7 (Sunday).
+	NextWeek int `json:"next_week"`
+	// Date format, 0 for DD-MM-YYYY and 1 for MM-DD-YYYY.
+	DateFormat int `json:"date_format"`
+	// Time format, 0 for 24h and 1 for 12h.
+	TimeFormat int `json:"time_format"`
+	// Language of the user.
+	Lang string `json:"lang"`
+	// Whether the user has a premium subscription.
+	IsPremium bool `json:"is_premium"`
+	// Date when the premium subscription ends (absent for non-premium users).
+	PremiumUntil *string `json:"premium_until"`
+	// ID of the Inbox project of the user.
+	InboxProjectID int `json:"inbox_project_id"`
+	// ID of the team Inbox project (absent if the user is not in a business account).
+	TeamInboxProjectID *int `json:"team_inbox_project_id"`
+	// Karma score of the user.
+	Karma float64 `json:"karma"`
+	// Karma trend ("up" or "down").
+	KarmaTrend string `json:"karma_trend"`
+	// Target number of tasks to complete per day.
+	DailyGoal int `json:"daily_goal"`
+	// Target number of tasks to complete per week.
+	WeeklyGoal int `json:"weekly_goal"`
+	// Days of the week not counted for goals, from 1 (Monday) to 7 (Sunday).
+	DaysOff []int `json:"days_off"`
+	// Date and time when the user joined.
+	JoinDate string `json:"join_date"`
+}
+
+// Gets the profile and settings of the current user.
+func (cl *Client) GetCurrentUser() (*CurrentUser, error) {
+	out := struct {
+		User *syncUser `json:"user"`
+	}{}
+	if _, err := cl.syncRead("*", []string{"user"}, &out); err != nil {
+		return nil, err
+	}
+	if out.User == nil {
+		return nil, errors.New("user is missing in the response")
+	}
+
+	return out.User.user(), nil
+}
+
+// Productivity stats of the current user.
+type ProductivityStats struct {
+	// Karma score.
+	Karma float64 `json:"karma"`
+	// Karma trend ("up" or "down").
+	KarmaTrend string `json:"karma_trend"`
+	// Date and time of the last karma update.
+	KarmaLastUpdate float64 `json:"karma_last_update"`
+	// Total number of completed tasks.
+	CompletedCount int `json:"completed_count"`
+	// Completed tasks per day of the last 7 days.
+	Days []*DailyCompletions `json:"days"`
+	// Completed tasks per week of the last 4 weeks.
+	Weeks []*WeeklyCompletions `json:"weeks"`
+	// Goals and streaks.
+	Goals *ProductivityGoals `json:"goals"`
+}
+
+// Completed tasks of a day.
+type DailyCompletions struct {
+	// Date in YYYY-MM-DD format.
+	Date string `json:"date"`
+	// Number of completed tasks.
+	TotalCompleted int `json:"total_completed"`
+	// Number of completed tasks per project.
+	Projects []*ProjectCompletions `json:"items"`
+}
+
+// Completed tasks of a week.
+type WeeklyCompletions struct {
+	// First date of the week in YYYY-MM-DD format.
+	From string `json:"from"`
+	// Last date of the week in YYYY-MM-DD format.
+	To string `json:"to"`
+	// Number of completed tasks.
+	TotalCompleted int `json:"total_completed"`
+	// Number of completed tasks per project.
+	Projects []*ProjectCompletions `json:"items"`
+}
+
+// Completed tasks of a project.
+type ProjectCompletions struct {
+	// Project ID.
+	ProjectID int `json:"id"`
+	// Number of completed tasks.
+	Completed int `json:"completed"`
+}
+
+// Productivity goals and streaks.
+type ProductivityGoals struct {
+	// Target number of tasks to complete per day.
+	DailyGoal int `json:"daily_goal"`
+	// Target number of tasks to complete per week.
+	WeeklyGoal int `json:"weekly_goal"`
+	// Days of the week not counted for goals, from 1 (Monday) to 7 (Sunday).
+	IgnoreDays []int `json:"ignore_days"`
+	// Whether vacation mode is enabled.
+	VacationMode bool `json:"vacation_mode"`
+	// Whether karma is disabled.
+	KarmaDisabled bool `json:"karma_disabled"`
+	// Current streak of days reaching the daily goal.
+	CurrentDailyStreak *Streak `json:"current_daily_streak"`
+	// Current streak of weeks reaching the weekly goal.
+	CurrentWeeklyStreak *Streak `json:"current_weekly_streak"`
+	// Longest streak of days reaching the daily goal.
+	MaxDailyStreak *Streak `json:"max_daily_streak"`
+	// Longest streak of weeks reaching the weekly goal.
+	MaxWeeklyStreak *Streak `json:"max_weekly_streak"`
+}
+
+// Streak of days or weeks reaching a goal.
+type Streak struct {
+	// Length of the streak.
+	Count int `json:"count"`
+	// First date of the streak in YYYY-MM-DD format.
+	Start string `json:"start"`
+	// Last date of the streak in YYYY-MM-DD format.
+	End string `json:"end"`
+}
+
+// Gets karma, completed task counts and goals of the current user.
+func (cl *Client) GetProductivityStats() (*ProductivityStats, error) {
+	sstats := syncStats{}
+	if err := cl.syncPost("/v8/completed/get_stats", map[string]interface{}{}, &sstats); err != nil {
+		return nil, err
+	}
+
+	return sstats.stats(), nil
+}
+
+// User object of the Sync API.
+type syncUser struct {
+	ID     int    `json:"id"`
+	Email  string `json:"email"`
+	Name   string `json:"full_name"`
+	TzInfo struct {
+		Timezone string `json:"timezone"`
+	} `json:"tz_info"`
+	StartDay     int     `json:"start_day"`
+	NextWeek     int     `json:"next_week"`
+	DateFormat   int     `json:"date_format"`
+	TimeFormat   int     `json:"time_format"`
+	Lang         string  `json:"lang"`
+	IsPremium    intBool `json:"is_premium"`
+	PremiumUntil *string `json:"premium_until"`
+	InboxProject int     `json:"inbox_project"`
+	TeamInbox    *int    `json:"team_inbox"`
+	Karma        float64 `json:"karma"`
+	KarmaTrend   string  `json:"karma_trend"`
+	DailyGoal    int     `json:"daily_goal"`
+	WeeklyGoal   int     `json:"weekly_goal"`
+	DaysOff      []int   `json:"days_off"`
+	JoinDate     string  `json:"join_date"`
+}
+
+func (su *syncUser) user() *CurrentUser {
+	return &CurrentUser{
+		ID:                 su.ID,
+		Name:               su.Name,
+		Email:              su.Email,
+		Timezone:           su.TzInfo.Timezone,
+		StartDay:           su.StartDay,
+		NextWeek:           su.NextWeek,
+		DateFormat:         su.DateFormat,
+		TimeFormat:         su.TimeFormat,
+		Lang:               su.Lang,
+		IsPremium:          bool(su.IsPremium),
+		PremiumUntil:       su.PremiumUntil,
+		InboxProjectID:     su.InboxProject,
+		TeamInboxProjectID: su.TeamInbox,
+		Karma:              su.Karma,
+		KarmaTrend:         su.KarmaTrend,
+		DailyGoal:          su.DailyGoal,
+		WeeklyGoal:         su.WeeklyGoal,
+		DaysOff:            su.DaysOff,
+		JoinDate:           su.JoinDate,
+	}
+}
+
+// Productivity stats object of the Sync API.
+type syncStats struct {
+	Karma           float64              `json:"karma"`
+	KarmaTrend      string               `json:"karma_trend"`
+	KarmaLastUpdate float64              `json:"karma_last_update"`
+	CompletedCount  int                  `json:"completed_count"`
+	DaysItems       []*DailyCompletions  `json:"days_items"`
+	WeekItems       []*WeeklyCompletions `json:"week_items"`
+	Goals           *struct {
+		DailyGoal           int     `json:"daily_goal"`
+		WeeklyGoal          int     `json:"weekly_goal"`
+		IgnoreDays          []int   `json:"ignore_days"`
+		VacationMode        intBool `json:"vacation_mode"`
+		KarmaDisabled       intBool `json:"karma_disabled"`
+		CurrentDailyStreak  *Streak `json:"current_daily_streak"`
+		CurrentWeeklyStreak *Streak `json:"current_weekly_streak"`
+		MaxDailyStreak      *Streak `json:"max_daily_streak"`
+		MaxWeeklyStreak     *Streak `json:"max_weekly_streak"`
+	} `json:"goals"`
+}
+
+func (ss *syncStats) stats() *ProductivityStats {
+	stats := &ProductivityStats{
+		Karma:           ss.Karma,
+		KarmaTrend:      ss.KarmaTrend,
+		KarmaLastUpdate: ss.KarmaLastUpdate,
+		CompletedCount:  ss.CompletedCount,
+		Days:            ss.DaysItems,
+		Weeks:           ss.WeekItems,
+	}
+	if g := ss.Goals; g != nil {
+		stats.Goals = &ProductivityGoals{
+			DailyGoal:           g.DailyGoal,
+			WeeklyGoal:          g.WeeklyGoal,
+			IgnoreDays:          g.IgnoreDays,
+			VacationMode:        bool(g.VacationMode),
+			KarmaDisabled:       bool(g.KarmaDisabled),
+			CurrentDailyStreak:  g.CurrentDailyStreak,
+			CurrentWeeklyStreak: g.CurrentWeeklyStreak,
+			MaxDailyStreak:      g.MaxDailyStreak,
+			MaxWeeklyStreak:     g.MaxWeeklyStreak,
+		}
+	}
+
+	return stats
+}
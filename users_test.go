@@ -0,0 +1,143 @@
+package todoist
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetCurrentUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		want    *CurrentUser
+		wantErr bool
+	}{
+		{
+			name: "should return the current user",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body: strings.NewReader(`{
+					"sync_token": "TOKEN",
+					"user": {
+						"id": 1, "email": "me@example.com", "full_name": "NAME",
+						"tz_info": { "timezone": "Asia/Tokyo", "gmt_string": "+09:00", "hours": 9, "minutes": 0, "is_dst": 0 },
+						"start_day": 1, "next_week": 1, "date_format": 0, "time_format": 1, "lang": "ja",
+						"is_premium": true, "premium_until": "2026-12-31T00:00:00Z",
+						"inbox_project": 10, "team_inbox": null,
+						"karma": 1234.5, "karma_trend": "up", "daily_goal": 5, "weekly_goal": 25, "days_off": [6, 7],
+						"join_date": "2020-01-01T00:00:00Z"
+					}
+				}`),
+			},
+			want: &CurrentUser{
+				ID: 1, Name: "NAME", Email: "me@example.com", Timezone: "Asia/Tokyo",
+				StartDay: 1, NextWeek: 1, DateFormat: 0, TimeFormat: 1, Lang: "ja",
+				IsPremium: true, PremiumUntil: String("2026-12-31T00:00:00Z"), InboxProjectID: 10,
+				Karma: 1234.5, KarmaTrend: "up", DailyGoal: 5, WeeklyGoal: 25, DaysOff: []int{6, 7},
+				JoinDate: "2020-01-01T00:00:00Z",
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", newSyncRequestForTest(map[string]interface{}{"sync_token": "*", "resource_types": []string{"user"}})).Return(tt.resp, nil)
+
+			user, err := cl.GetCurrentUser()
+
+			assert.Equal(t, tt.want, user)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_GetProductivityStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *restResponse
+		want    *ProductivityStats
+		wantErr bool
+	}{
+		{
+			name: "should return productivity stats",
+			resp: &restResponse{
+				StatusCode: http.StatusOK,
+				Body: strings.NewReader(`{
+					"karma": 1234.5, "karma_trend": "up", "karma_last_update": 5.0, "completed_count": 100,
+					"days_items": [{ "date": "2026-10-17", "total_completed": 3, "items": [{ "id": 10, "completed": 3 }] }],
+					"week_items": [{ "from": "2026-10-12", "to": "2026-10-18", "total_completed": 12, "items": [{ "id": 10, "completed": 12 }] }],
+					"goals": {
+						"daily_goal": 5, "weekly_goal": 25, "ignore_days": [6, 7], "vacation_mode": 0, "karma_disabled": 1,
+						"current_daily_streak": { "count": 2, "start": "2026-10-16", "end": "2026-10-17" },
+						"current_weekly_streak": { "count": 0, "start": "", "end": "" },
+						"max_daily_streak": { "count": 10, "start": "2026-01-01", "end": "2026-01-10" },
+						"max_weekly_streak": { "count": 3, "start": "2026-02-02", "end": "2026-02-22" }
+					}
+				}`),
+			},
+			want: &ProductivityStats{
+				Karma: 1234.5, KarmaTrend: "up", KarmaLastUpdate: 5, CompletedCount: 100,
+				Days:  []*DailyCompletions{{Date: "2026-10-17", TotalCompleted: 3, Projects: []*ProjectCompletions{{ProjectID: 10, Completed: 3}}}},
+				Weeks: []*WeeklyCompletions{{From: "2026-10-12", To: "2026-10-18", TotalCompleted: 12, Projects: []*ProjectCompletions{{ProjectID: 10, Completed: 12}}}},
+				Goals: &ProductivityGoals{
+					DailyGoal: 5, WeeklyGoal: 25, IgnoreDays: []int{6, 7}, VacationMode: false, KarmaDisabled: true,
+					CurrentDailyStreak:  &Streak{Count: 2, Start: "2026-10-16", End: "2026-10-17"},
+					CurrentWeeklyStreak: &Streak{},
+					MaxDailyStreak:      &Streak{Count: 10, Start: "2026-01-01", End: "2026-01-10"},
+					MaxWeeklyStreak:     &Streak{Count: 3, Start: "2026-02-02", End: "2026-02-22"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the request fails",
+			resp: &restResponse{
+				StatusCode: http.StatusBadRequest,
+				Body:       strings.NewReader("ERROR_RESPONSE"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, api := newClientForTest()
+			api.On("Do", &restRequest{
+				URL:     "https://api.todoist.com/sync/v8/completed/get_stats",
+				Method:  http.MethodPost,
+				Payload: map[string]interface{}{},
+				Headers: map[string]string{"Authorization": "Bearer TOKEN", "Content-Type": "application/json"},
+			}).Return(tt.resp, nil)
+
+			stats, err := cl.GetProductivityStats()
+
+			assert.Equal(t, tt.want, stats)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, RequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			api.AssertExpectations(t)
+		})
+	}
+}
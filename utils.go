@@ -1,6 +1,8 @@
 package todoist
 
 import (
+	"bytes"
+	"fmt"
 	"strconv"
 
 	"github.com/mitchellh/mapstructure"
@@ -48,3 +50,18 @@ func toMap(obj interface{}, dest map[string]interface{}) error {
 
 	return nil
 }
+
+// Boolean that is decoded from either a JSON boolean or an integer (1 is true and 0 is false), as used by the Sync API.
+type intBool bool
+
+func (b *intBool) UnmarshalJSON(data []byte) error {
+	switch string(bytes.TrimSpace(data)) {
+	case "true", "1":
+		*b = true
+	case "false", "0", "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean: %s", data)
+	}
+	return nil
+}
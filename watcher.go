@@ -0,0 +1,260 @@
+package todoist
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+const defaultWatchInterval time.Duration = 30 * time.Second
+
+// Type of watch events.
+type WatchEventType string
+
+const (
+	WatchCreated   WatchEventType = "created"
+	WatchUpdated   WatchEventType = "updated"
+	WatchCompleted WatchEventType = "completed"
+	WatchDeleted   WatchEventType = "deleted"
+)
+
+// Type of watched objects.
+type WatchObjectType string
+
+const (
+	WatchTasks    WatchObjectType = "task"
+	WatchProjects WatchObjectType = "project"
+	WatchSections WatchObjectType = "section"
+	WatchLabels   WatchObjectType = "label"
+	WatchComments WatchObjectType = "comment"
+)
+
+// Resource types of the Sync API per watched object type.
+var watchResourceTypes = map[WatchObjectType][]string{
+	WatchTasks:    {"items"},
+	WatchProjects: {"projects"},
+	WatchSections: {"sections"},
+	WatchLabels:   {"labels"},
+	WatchComments: {"notes", "project_notes"},
+}
+
+// Change of an object detected by a Watcher.
+type WatchEvent struct {
+	// Type of the change.
+	Type WatchEventType
+	// Type of the changed object.
+	ObjectType WatchObjectType
+	// ID of the changed object.
+	ID int
+	// Changed object, which is *Task, *Project, *Section, *Label or *Comment.
+	// For deleted and completed objects, it is the last known state.
+	Object interface{}
+	// Changed fields (only for updated objects).
+	Changes []FieldChange
+}
+
+// Change of a field of an object.
+type FieldChange struct {
+	// Name of the field (e.g. "Content").
+	Field string
+	// Value before the change.
+	// Pointers are dereferenced, and nil pointers are nil.
+	Old interface{}
+	// Value after the change.
+	// Pointers are dereferenced, and nil pointers are nil.
+	New interface{}
+}
+
+// Options for watching changes.
+type WatcherOptions struct {
+	// Interval between polls.
+	// Default is 30 seconds.
+	Interval time.Duration
+	// Types of objects to watch.
+	// Default is all types.
+	Objects []WatchObjectType
+	// Whether to emit created events for all objects of the first poll.
+	// By default, the first poll only records the initial state.
+	EmitInitial bool
+	// Function called when a poll fails.
+	// Polling continues with the next interval.
+	OnError func(err error)
+}
+
+// Watcher polls changes with Sync API tokens and emits them as events.
+// Completed tasks are forgotten, so a task uncompleted later is emitted as created.
+type Watcher struct {
+	cl   *Client
+	opts WatcherOptions
+
+	events    chan *WatchEvent
+	syncToken string
+	objects   map[WatchObjectType]map[int]interface{}
+}
+
+// Returns new watcher.
+func NewWatcher(cl *Client, opts *WatcherOptions) *Watcher {
+	w := &Watcher{
+		cl:        cl,
+		events:    make(chan *WatchEvent),
+		syncToken: "*",
+		objects:   map[WatchObjectType]map[int]interface{}{},
+	}
+	if opts != nil {
+		w.opts = *opts
+	}
+	if w.opts.Interval <= 0 {
+		w.opts.Interval = defaultWatchInterval
+	}
+	if len(w.opts.Objects) == 0 {
+		w.opts.Objects = []WatchObjectType{WatchProjects, WatchSections, WatchLabels, WatchTasks, WatchComments}
+	}
+	for _, typ := range w.opts.Objects {
+		w.objects[typ] = map[int]interface{}{}
+	}
+
+	return w
+}
+
+// Returns the channel of events.
+// It is closed when Run returns.
+func (w *Watcher) Events() <-chan *WatchEvent {
+	return w.events
+}
+
+// Polls changes until ctx is done and sends them to the events channel.
+// It must be called only once.
+// When ctx is done, it stops after the current poll and returns nil.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.events)
+
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.poll(ctx); err != nil && ctx.Err() == nil && w.opts.OnError != nil {
+			w.opts.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reads changes since the last poll and emits events for them.
+func (w *Watcher) poll(ctx context.Context) error {
+	types := []string{}
+	for _, typ := range w.opts.Objects {
+		types = append(types, watchResourceTypes[typ]...)
+	}
+
+	res := syncResources{}
+	resp, err := w.cl.WithContext(ctx).syncRead(w.syncToken, types, &res)
+	if err != nil {
+		return err
+	}
+	initial := w.syncToken == "*"
+	w.syncToken = resp.SyncToken
+
+	emit := func(ev *WatchEvent) bool {
+		if ev == nil || (initial && !w.opts.EmitInitial) {
+			return true
+		}
+		select {
+		case w.events <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for _, sproj := range res.Projects {
+		if !emit(w.apply(WatchProjects, sproj.ID, sproj.project(), bool(sproj.IsDeleted), false)) {
+			return ctx.Err()
+		}
+	}
+	for _, ssec := range res.Sections {
+		if !emit(w.apply(WatchSections, ssec.ID, ssec.section(), bool(ssec.IsDeleted), false)) {
+			return ctx.Err()
+		}
+	}
+	for _, slabel := range res.Labels {
+		if !emit(w.apply(WatchLabels, slabel.ID, slabel.label(), bool(slabel.IsDeleted), false)) {
+			return ctx.Err()
+		}
+	}
+	for _, stask := range res.Items {
+		if !emit(w.apply(WatchTasks, stask.ID, stask.task(), bool(stask.IsDeleted), bool(stask.Checked))) {
+			return ctx.Err()
+		}
+	}
+	for _, snote := range append(res.Notes, res.ProjectNotes...) {
+		if !emit(w.apply(WatchComments, snote.ID, snote.comment(), bool(snote.IsDeleted), false)) {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Applies a changed object to the state and returns the event for it, or nil if nothing changed.
+func (w *Watcher) apply(typ WatchObjectType, id int, obj interface{}, deleted, completed bool) *WatchEvent {
+	objs := w.objects[typ]
+	if objs == nil {
+		return nil
+	}
+	prev, known := objs[id]
+
+	switch {
+	case deleted || completed:
+		if !known {
+			return nil
+		}
+		delete(objs, id)
+		ev := &WatchEvent{Type: WatchDeleted, ObjectType: typ, ID: id, Object: prev}
+		if completed && !deleted {
+			ev.Type = WatchCompleted
+		}
+		return ev
+	case !known:
+		objs[id] = obj
+		return &WatchEvent{Type: WatchCreated, ObjectType: typ, ID: id, Object: obj}
+	default:
+		objs[id] = obj
+		changes := diffFields(prev, obj)
+		if len(changes) == 0 {
+			return nil
+		}
+		return &WatchEvent{Type: WatchUpdated, ObjectType: typ, ID: id, Object: obj, Changes: changes}
+	}
+}
+
+// Returns changed fields between two pointers to structs of the same type.
+func diffFields(old, new interface{}) []FieldChange {
+	ov := reflect.ValueOf(old).Elem()
+	nv := reflect.ValueOf(new).Elem()
+
+	changes := []FieldChange{}
+	for i := 0; i < ov.NumField(); i++ {
+		o, n := ov.Field(i).Interface(), nv.Field(i).Interface()
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: ov.Type().Field(i).Name, Old: derefValue(ov.Field(i)), New: derefValue(nv.Field(i))})
+	}
+
+	return changes
+}
+
+func derefValue(v reflect.Value) interface{} {
+	if v.Kind() != reflect.Ptr {
+		return v.Interface()
+	}
+	if v.IsNil() {
+		return nil
+	}
+	return v.Elem().Interface()
+}
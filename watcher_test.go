@@ -0,0 +1,148 @@
+package todoist
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newWatchRequestForTest(ctx context.Context, syncToken string) *restRequest {
+	return withContextForTest(ctx, newSyncRequestForTest(map[string]interface{}{"sync_token": syncToken, "resource_types": []string{"items", "projects"}}))
+}
+
+func newWatchResponseForTest(body string) *restResponse {
+	return &restResponse{StatusCode: http.StatusOK, Body: strings.NewReader(body)}
+}
+
+func TestWatcher_Run(t *testing.T) {
+	t.Run("should emit changes since the first poll", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		api.On("Do", newWatchRequestForTest(ctx, "*")).Return(newWatchResponseForTest(`{
+			"sync_token": "T1", "full_sync": true,
+			"projects": [{ "id": 1, "name": "PROJECT", "child_order": 1 }],
+			"items": [
+				{ "id": 10, "project_id": 1, "content": "A", "priority": 1, "child_order": 1 },
+				{ "id": 11, "project_id": 1, "content": "B", "priority": 1, "child_order": 2 }
+			]
+		}`), nil).Once()
+		api.On("Do", newWatchRequestForTest(ctx, "T1")).Return(newWatchResponseForTest(`{
+			"sync_token": "T2", "full_sync": false,
+			"projects": [{ "id": 1, "name": "PROJECT", "child_order": 1, "is_deleted": 1 }],
+			"items": [
+				{ "id": 10, "project_id": 1, "content": "AA", "priority": 4, "child_order": 1 },
+				{ "id": 11, "project_id": 1, "content": "B", "priority": 1, "child_order": 2, "checked": 1 },
+				{ "id": 12, "project_id": 1, "content": "C", "priority": 1, "child_order": 3, "due": { "date": "2026-10-20", "string": "Oct 20" } }
+			]
+		}`), nil).Once()
+		api.On("Do", newWatchRequestForTest(ctx, "T2")).Return(newWatchResponseForTest(`{ "sync_token": "T2", "full_sync": false }`), nil).Maybe()
+
+		w := NewWatcher(cl, &WatcherOptions{Interval: time.Millisecond, Objects: []WatchObjectType{WatchTasks, WatchProjects}})
+		done := make(chan error)
+		go func() { done <- w.Run(ctx) }()
+
+		evs := []*WatchEvent{}
+		for ev := range w.Events() {
+			evs = append(evs, ev)
+			if len(evs) == 4 {
+				cancel()
+			}
+		}
+
+		assert.NoError(t, <-done)
+		assert.Equal(t, []*WatchEvent{
+			{Type: WatchDeleted, ObjectType: WatchProjects, ID: 1, Object: &Project{ID: 1, Name: "PROJECT", Order: 1, URL: "https://todoist.com/showProject?id=1"}},
+			{Type: WatchUpdated, ObjectType: WatchTasks, ID: 10, Object: &Task{ID: 10, ProjectID: 1, Content: "AA", Priority: 4, Order: 1, LabelIDs: []int{}, URL: "https://todoist.com/showTask?id=10"}, Changes: []FieldChange{
+				{Field: "Content", Old: "A", New: "AA"},
+				{Field: "Priority", Old: 1, New: 4},
+			}},
+			{Type: WatchCompleted, ObjectType: WatchTasks, ID: 11, Object: &Task{ID: 11, ProjectID: 1, Content: "B", Priority: 1, Order: 2, LabelIDs: []int{}, URL: "https://todoist.com/showTask?id=11"}},
+			{Type: WatchCreated, ObjectType: WatchTasks, ID: 12, Object: &Task{ID: 12, ProjectID: 1, Content: "C", Priority: 1, Order: 3, LabelIDs: []int{}, URL: "https://todoist.com/showTask?id=12", Due: &Due{Date: "2026-10-20", String: "Oct 20"}}},
+		}, evs)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should emit the initial state if EmitInitial is set", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		api.On("Do", newWatchRequestForTest(ctx, "*")).Return(newWatchResponseForTest(`{
+			"sync_token": "T1", "full_sync": true,
+			"items": [{ "id": 10, "project_id": 1, "content": "A", "priority": 1, "child_order": 1 }]
+		}`), nil).Once()
+		api.On("Do", newWatchRequestForTest(ctx, "T1")).Return(newWatchResponseForTest(`{ "sync_token": "T1", "full_sync": false }`), nil).Maybe()
+
+		w := NewWatcher(cl, &WatcherOptions{Interval: time.Millisecond, Objects: []WatchObjectType{WatchTasks, WatchProjects}, EmitInitial: true})
+		go func() { _ = w.Run(ctx) }()
+
+		ev := <-w.Events()
+		cancel()
+		for range w.Events() {
+		}
+
+		assert.Equal(t, WatchCreated, ev.Type)
+		assert.Equal(t, 10, ev.ID)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should report poll errors and keep polling", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		api.On("Do", newWatchRequestForTest(ctx, "*")).Return(&restResponse{StatusCode: http.StatusBadRequest, Body: strings.NewReader("ERROR_RESPONSE")}, nil).Twice()
+
+		errs := []error{}
+		w := NewWatcher(cl, &WatcherOptions{Interval: time.Millisecond, Objects: []WatchObjectType{WatchTasks, WatchProjects}, OnError: func(err error) {
+			errs = append(errs, err)
+			if len(errs) == 2 {
+				cancel()
+			}
+		}})
+
+		err := w.Run(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, errs, 2)
+		assert.IsType(t, RequestError{}, errs[0])
+		_, open := <-w.Events()
+		assert.False(t, open)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("should return if the context is canceled during a request", func(t *testing.T) {
+		cl, api := newClientForTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		sent := make(chan struct{})
+		api.On("Do", newWatchRequestForTest(ctx, "*")).Run(func(args mock.Arguments) {
+			close(sent)
+			<-args.Get(0).(*restRequest).Context.Done()
+		}).Return(nil, context.Canceled).Once()
+
+		w := NewWatcher(cl, &WatcherOptions{Interval: time.Millisecond, Objects: []WatchObjectType{WatchTasks, WatchProjects}})
+		done := make(chan error)
+		go func() { done <- w.Run(ctx) }()
+
+		<-sent
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("Run did not return")
+		}
+		api.AssertExpectations(t)
+	})
+}
+
+func TestNewWatcher(t *testing.T) {
+	cl, _ := newClientForTest()
+
+	w := NewWatcher(cl, nil)
+
+	assert.Equal(t, defaultWatchInterval, w.opts.Interval)
+	assert.Equal(t, []WatchObjectType{WatchProjects, WatchSections, WatchLabels, WatchTasks, WatchComments}, w.opts.Objects)
+}